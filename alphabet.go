@@ -0,0 +1,117 @@
+package damm
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Alphabet maps symbol values 0..Len()-1 to ASCII characters and back.
+type Alphabet struct {
+	symbols string
+	values  [256]int16
+}
+
+var (
+	// Crockford is Douglas Crockford's Base32 alphabet. Decoding is case
+	// insensitive and accepts I and L for 1 and O for 0.
+	Crockford = mustAlphabet("0123456789ABCDEFGHJKMNPQRSTVWXYZ", map[byte]byte{
+		'I': '1', 'L': '1', 'O': '0',
+	}, true)
+
+	// Base36 is the digits followed by the upper case letters. Decoding is
+	// case insensitive.
+	Base36 = mustAlphabet("0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ", nil, true)
+
+	// Base62 is the digits followed by the upper and lower case letters.
+	Base62 = mustAlphabet("0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz", nil, false)
+
+	// Base64URL is the URL and filename safe alphabet of RFC 4648.
+	Base64URL = mustAlphabet("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_", nil, false)
+)
+
+// NewAlphabet returns an alphabet whose symbol values are the indices of the
+// characters in symbols. The characters must be distinct printable ASCII.
+func NewAlphabet(symbols string) (*Alphabet, error) {
+	return newAlphabet(symbols, nil, false)
+}
+
+func newAlphabet(symbols string, aliases map[byte]byte, fold bool) (*Alphabet, error) {
+	if len(symbols) < 2 {
+		return nil, errors.New("damm: alphabet needs at least 2 symbols")
+	}
+	a := &Alphabet{symbols: symbols}
+	for i := range a.values {
+		a.values[i] = -1
+	}
+	for i := range len(symbols) {
+		c := symbols[i]
+		if c <= ' ' || c > '~' {
+			return nil, fmt.Errorf("damm: invalid alphabet symbol %q", c)
+		}
+		if a.values[c] >= 0 {
+			return nil, fmt.Errorf("damm: duplicate alphabet symbol %q", c)
+		}
+		a.values[c] = int16(i)
+	}
+	for alias, c := range aliases {
+		a.values[alias] = a.values[c]
+	}
+	if fold {
+		for c := 'A'; c <= 'Z'; c++ {
+			a.values[c|0x20] = a.values[c]
+		}
+	}
+	return a, nil
+}
+
+func mustAlphabet(symbols string, aliases map[byte]byte, fold bool) *Alphabet {
+	a, err := newAlphabet(symbols, aliases, fold)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+func (a *Alphabet) Len() int {
+	return len(a.symbols)
+}
+
+func (a *Alphabet) String() string {
+	return a.symbols
+}
+
+// Symbol returns the character for v. It panics if v is out of range.
+func (a *Alphabet) Symbol(v int) byte {
+	return a.symbols[v]
+}
+
+// Value returns the value of c and whether c belongs to the alphabet.
+func (a *Alphabet) Value(c byte) (int, bool) {
+	v := a.values[c]
+	return int(v), v >= 0
+}
+
+// Encode returns the characters for digits.
+func (a *Alphabet) Encode(digits []int) (string, error) {
+	b := make([]byte, len(digits))
+	for i, v := range digits {
+		if v < 0 || v >= len(a.symbols) {
+			return "", fmt.Errorf("damm: digit %d out of range at offset %d", v, i)
+		}
+		b[i] = a.symbols[v]
+	}
+	return string(b), nil
+}
+
+// Decode returns the values of the characters in s.
+func (a *Alphabet) Decode(s string) ([]int, error) {
+	digits := make([]int, len(s))
+	for i := range len(s) {
+		v, ok := a.Value(s[i])
+		if !ok {
+			return nil, &SymbolError{Offset: i, Symbol: s[i]}
+		}
+		digits[i] = v
+	}
+	return digits, nil
+}
@@ -0,0 +1,83 @@
+package damm_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-oss/damm"
+)
+
+func TestAlphabet_builtin(t *testing.T) {
+	t.Parallel()
+	if got := damm.Crockford.Len(); got != damm.New32().Modulus() {
+		t.Errorf("Crockford.Len() = %d; want %d", got, damm.New32().Modulus())
+	}
+	if got := damm.Base36.Len(); got != damm.New36().Modulus() {
+		t.Errorf("Base36.Len() = %d; want %d", got, damm.New36().Modulus())
+	}
+	if got := damm.Base62.Len(); got != damm.New62().Modulus() {
+		t.Errorf("Base62.Len() = %d; want %d", got, damm.New62().Modulus())
+	}
+	if got := damm.Base64URL.Len(); got != damm.New64().Modulus() {
+		t.Errorf("Base64URL.Len() = %d; want %d", got, damm.New64().Modulus())
+	}
+}
+
+func TestAlphabet_Decode(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		a    *damm.Alphabet
+		s    string
+		want []int
+	}{
+		{damm.Crockford, "0123ABZ", []int{0, 1, 2, 3, 10, 11, 31}},
+		{damm.Crockford, "oilabz", []int{0, 1, 1, 10, 11, 31}},
+		{damm.Base36, "09azAZ", []int{0, 9, 10, 35, 10, 35}},
+		{damm.Base62, "09AZaz", []int{0, 9, 10, 35, 36, 61}},
+		{damm.Base64URL, "AZaz09-_", []int{0, 25, 26, 51, 52, 61, 62, 63}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			got, err := tt.a.Decode(tt.s)
+			if err != nil {
+				t.Fatalf("Decode(%q) error: %v", tt.s, err)
+			}
+			if !equalInts(got, tt.want) {
+				t.Errorf("Decode(%q) = %v; want %v", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAlphabet_Decode_invalid(t *testing.T) {
+	t.Parallel()
+	_, err := damm.Crockford.Decode("12U4")
+	var se *damm.SymbolError
+	if !errors.As(err, &se) {
+		t.Fatalf("Decode error = %v; want *SymbolError", err)
+	}
+	if se.Offset != 2 || se.Symbol != 'U' {
+		t.Errorf("SymbolError = %+v; want offset 2, symbol 'U'", se)
+	}
+}
+
+func TestNewAlphabet_invalid(t *testing.T) {
+	t.Parallel()
+	for _, s := range []string{"", "a", "aba", "ab c", "ab\xff"} {
+		if _, err := damm.NewAlphabet(s); err == nil {
+			t.Errorf("NewAlphabet(%q) error = nil; want error", s)
+		}
+	}
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,237 @@
+package damm
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// batchChunk is the number of codes a VerifyBatch worker takes at a time.
+// It is large enough to make the atomic counter cheap and small enough to
+// balance the load when some codes are much longer than others.
+const batchChunk = 1024
+
+// GenerateBatch returns d.Generate(payloads[i]) for each payload, computed
+// on GOMAXPROCS goroutines. d must be safe for concurrent use, as all the
+// checkers of this package are. A payload on which d panics gets -1, as the
+// panic could not be recovered from a worker goroutine.
+func GenerateBatch(d Checker, payloads [][]int) []int {
+	checks := make([]int, len(payloads))
+	parallel(len(payloads), func(lo, hi int) {
+		checkAll(d.Generate, payloads[lo:hi], checks[lo:hi], -1)
+	})
+	return checks
+}
+
+// VerifyBatch returns d.Verify(codes[i]) for each code, computed on
+// GOMAXPROCS goroutines. d must be safe for concurrent use. A code on which
+// d panics is reported as invalid.
+func VerifyBatch(d Checker, codes [][]int) []bool {
+	valid := make([]bool, len(codes))
+	parallel(len(codes), func(lo, hi int) {
+		checkAll(d.Verify, codes[lo:hi], valid[lo:hi], false)
+	})
+	return valid
+}
+
+// checkAll sets out[i] = f(in[i]) for each i, or bad if f panics.
+func checkAll[T any](f func([]int) T, in [][]int, out []T, bad T) {
+	for i := 0; i < len(in); i++ {
+		i = checkFrom(f, in, out, bad, i)
+	}
+}
+
+// checkFrom is checkAll from in[i] on, returning len(in), or the index at
+// which f panicked so that checkAll resumes after it.
+func checkFrom[T any](f func([]int) T, in [][]int, out []T, bad T, i int) (next int) {
+	defer func() {
+		if recover() != nil {
+			out[i], next = bad, i
+		}
+	}()
+	for ; i < len(in); i++ {
+		out[i] = f(in[i])
+	}
+	return i
+}
+
+// parallel calls f(lo, hi) for chunks [lo, hi) covering [0, n) on up to
+// GOMAXPROCS goroutines, which take chunks in turn.
+func parallel(n int, f func(lo, hi int)) {
+	workers := min(runtime.GOMAXPROCS(0), (n+batchChunk-1)/batchChunk)
+	if workers <= 1 {
+		f(0, n)
+		return
+	}
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				lo := int(next.Add(batchChunk)) - batchChunk
+				if lo >= n {
+					return
+				}
+				f(lo, min(lo+batchChunk, n))
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// Result is the outcome of verifying the code received at Index, counting
+// from 0, by VerifyChannel.
+type Result struct {
+	Index int
+	Valid bool
+}
+
+// channelChunk is the most codes VerifyChannel hands to a worker at a time.
+const channelChunk = 256
+
+// VerifyChannel verifies the codes received from codes on GOMAXPROCS
+// goroutines and sends the results on the returned channel, which is closed
+// once codes is closed and all its results are delivered, or soon after ctx
+// is done. If ordered is set, results are delivered in the order the codes
+// were received; otherwise as soon as they are ready.
+//
+// Codes are handed to the goroutines in chunks of those already waiting on
+// codes, so a fast sender pays for little more than its channel operations
+// and a slow one is not held up waiting for a chunk to fill. Those channel
+// operations still cost more than verifying a short code, so VerifyBatch is
+// much faster for codes already in memory. At most a few chunks per
+// goroutine are in flight at a time, so a slow reader of the results holds
+// back the reading of codes instead of letting results pile up.
+//
+// d must be safe for concurrent use, and callers must not modify a code
+// after sending it. A code on which d panics is reported as invalid.
+func VerifyChannel(ctx context.Context, d Checker, codes <-chan []int, ordered bool) <-chan Result {
+	type chunk struct {
+		seq   int // number of the chunk, counting from 0
+		index int // index of the first code
+		codes [][]int
+		valid []bool
+	}
+	workers := runtime.GOMAXPROCS(0)
+	// A token is taken for every chunk read and returned when its results
+	// are delivered, which bounds the chunks waiting to be reordered.
+	tokens := make(chan struct{}, 4*workers)
+	jobs := make(chan *chunk, workers)
+	done := make(chan *chunk, workers)
+	out := make(chan Result, channelChunk)
+
+	go func() {
+		defer close(jobs)
+		index := 0
+		for seq := 0; ; seq++ {
+			select {
+			case tokens <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			c := &chunk{seq: seq, index: index}
+			// Wait for the first code, then take those already waiting.
+			select {
+			case code, ok := <-codes:
+				if !ok {
+					return
+				}
+				c.codes = append(c.codes, code)
+			case <-ctx.Done():
+				return
+			}
+			closed := false
+		fill:
+			for len(c.codes) < channelChunk {
+				select {
+				case code, ok := <-codes:
+					if !ok {
+						closed = true
+						break fill
+					}
+					c.codes = append(c.codes, code)
+				default:
+					break fill
+				}
+			}
+			index += len(c.codes)
+			select {
+			case jobs <- c:
+			case <-ctx.Done():
+				return
+			}
+			if closed {
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for c := range jobs {
+				c.valid = make([]bool, len(c.codes))
+				checkAll(d.Verify, c.codes, c.valid, false)
+				select {
+				case done <- c:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	go func() {
+		defer close(out)
+		send := func(c *chunk) bool {
+			for i, valid := range c.valid {
+				if ctx.Err() != nil {
+					return false
+				}
+				r := Result{Index: c.index + i, Valid: valid}
+				// Try without ctx first, which is cheaper while the
+				// reader keeps up.
+				select {
+				case out <- r:
+					continue
+				default:
+				}
+				select {
+				case out <- r:
+				case <-ctx.Done():
+					return false
+				}
+			}
+			<-tokens
+			return true
+		}
+		pending := make(map[int]*chunk)
+		next := 0
+		for c := range done {
+			if !ordered {
+				if !send(c) {
+					return
+				}
+				continue
+			}
+			pending[c.seq] = c
+			for c, ok := pending[next]; ok; c, ok = pending[next] {
+				delete(pending, next)
+				if !send(c) {
+					return
+				}
+				next++
+			}
+		}
+	}()
+	return out
+}
@@ -0,0 +1,198 @@
+package damm_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/go-oss/damm"
+)
+
+// randomCodes returns n codes of 8 to 23 digits for d, about half of which
+// end with a valid check digit.
+func randomCodes(d damm.Damm, n int) [][]int {
+	r := rand.New(rand.NewPCG(1, 2))
+	codes := make([][]int, n)
+	for i := range codes {
+		code := make([]int, 8+r.IntN(16))
+		for j := range code {
+			code[j] = r.IntN(d.Modulus())
+		}
+		if r.IntN(2) == 0 {
+			code[len(code)-1] = d.Generate(code[:len(code)-1])
+		}
+		codes[i] = code
+	}
+	return codes
+}
+
+func TestVerifyBatch(t *testing.T) {
+	t.Parallel()
+	for _, n := range []int{0, 1, 1000, 10000} {
+		d := damm.New32()
+		codes := randomCodes(d, n)
+		got := damm.VerifyBatch(d, codes)
+		if len(got) != n {
+			t.Fatalf("len(VerifyBatch(%d codes)) = %d; want %d", n, len(got), n)
+		}
+		for i, code := range codes {
+			if want := d.Verify(code); got[i] != want {
+				t.Errorf("VerifyBatch(...)[%d] = %v; want %v", i, got[i], want)
+			}
+		}
+	}
+}
+
+func TestGenerateBatch(t *testing.T) {
+	t.Parallel()
+	d := damm.New64()
+	payloads := randomCodes(d, 5000)
+	got := damm.GenerateBatch(d, payloads)
+	for i, payload := range payloads {
+		if want := d.Generate(payload); got[i] != want {
+			t.Errorf("GenerateBatch(...)[%d] = %d; want %d", i, got[i], want)
+		}
+	}
+}
+
+// panicky is a Checker that panics on codes starting with 99.
+type panicky struct{ d damm.Damm }
+
+func (p panicky) Generate(digits []int) int {
+	if len(digits) > 0 && digits[0] == 99 {
+		panic("bad digit")
+	}
+	return p.d.Generate(digits)
+}
+
+func (p panicky) Verify(digits []int) bool {
+	p.Generate(digits)
+	return p.d.Verify(digits)
+}
+
+func TestBatch_panic(t *testing.T) {
+	t.Parallel()
+	d := damm.New32()
+	codes := randomCodes(d, 5000)
+	for _, i := range []int{0, 1, 1023, 1024, 2500, 4999} {
+		codes[i] = []int{99, 1, 2}
+	}
+	bad := func(i int) bool { return codes[i][0] == 99 }
+	valid := damm.VerifyBatch(panicky{d}, codes)
+	checks := damm.GenerateBatch(panicky{d}, codes)
+	results := make([]bool, len(codes))
+	for r := range damm.VerifyChannel(context.Background(), panicky{d}, sendCodes(codes), false) {
+		results[r.Index] = r.Valid
+	}
+	for i, code := range codes {
+		wantValid, wantCheck := false, -1
+		if !bad(i) {
+			wantValid, wantCheck = d.Verify(code), d.Generate(code)
+		}
+		if valid[i] != wantValid || results[i] != wantValid {
+			t.Errorf("code %d: VerifyBatch = %v, VerifyChannel = %v; want %v", i, valid[i], results[i], wantValid)
+		}
+		if checks[i] != wantCheck {
+			t.Errorf("code %d: GenerateBatch = %d; want %d", i, checks[i], wantCheck)
+		}
+	}
+}
+
+// sendCodes sends codes on a buffered channel, as a reader of codes from a
+// file would, and closes it.
+func sendCodes(codes [][]int) <-chan []int {
+	ch := make(chan []int, 1024)
+	go func() {
+		defer close(ch)
+		for _, code := range codes {
+			ch <- code
+		}
+	}()
+	return ch
+}
+
+func TestVerifyChannel(t *testing.T) {
+	t.Parallel()
+	d := damm.New32()
+	codes := randomCodes(d, 5000)
+	for _, ordered := range []bool{true, false} {
+		var results []damm.Result
+		for r := range damm.VerifyChannel(context.Background(), d, sendCodes(codes), ordered) {
+			results = append(results, r)
+		}
+		if len(results) != len(codes) {
+			t.Fatalf("VerifyChannel(ordered=%v) delivered %d results; want %d", ordered, len(results), len(codes))
+		}
+		if !ordered {
+			slices.SortFunc(results, func(a, b damm.Result) int { return a.Index - b.Index })
+		}
+		for i, r := range results {
+			if want := (damm.Result{Index: i, Valid: d.Verify(codes[i])}); r != want {
+				t.Fatalf("VerifyChannel(ordered=%v) result %d = %+v; want %+v", ordered, i, r, want)
+			}
+		}
+	}
+}
+
+func TestVerifyChannel_cancel(t *testing.T) {
+	t.Parallel()
+	d := damm.New32()
+	ctx, cancel := context.WithCancel(context.Background())
+	codes := make(chan []int)
+	go func() {
+		// Never closed: only the cancellation can end VerifyChannel.
+		for {
+			select {
+			case codes <- []int{1, 2, 3}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	results := damm.VerifyChannel(ctx, d, codes, true)
+	for range 100 {
+		<-results
+	}
+	cancel()
+	for range results {
+	}
+}
+
+// benchmarkBatch is the number of codes verified per operation by the
+// batch benchmarks. Run them with -cpu 1,2,4,8 to see how they scale.
+const benchmarkBatch = 1 << 16
+
+func BenchmarkVerify_sequential(b *testing.B) {
+	d := damm.New32()
+	codes := randomCodes(d, benchmarkBatch)
+	b.ResetTimer()
+	for range b.N {
+		for _, code := range codes {
+			d.Verify(code)
+		}
+	}
+}
+
+func BenchmarkVerifyBatch(b *testing.B) {
+	d := damm.New32()
+	codes := randomCodes(d, benchmarkBatch)
+	b.ResetTimer()
+	for range b.N {
+		damm.VerifyBatch(d, codes)
+	}
+}
+
+func BenchmarkVerifyChannel(b *testing.B) {
+	d := damm.New32()
+	codes := randomCodes(d, benchmarkBatch)
+	for _, ordered := range []bool{false, true} {
+		b.Run(fmt.Sprintf("ordered=%v", ordered), func(b *testing.B) {
+			for range b.N {
+				for range damm.VerifyChannel(context.Background(), d, sendCodes(codes), ordered) {
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package damm
+
+import (
+	"errors"
+	"math/big"
+	"slices"
+)
+
+var (
+	// ErrNegative is returned for negative numbers, which have no digit
+	// expansion.
+	ErrNegative = errors.New("damm: negative number")
+
+	// ErrSyntax is returned for strings that are not decimal numbers.
+	ErrSyntax = errors.New("damm: invalid decimal number")
+)
+
+// BigDigits returns the base-Modulus() digits of n, most significant first.
+// Zero has no digits.
+func BigDigits(d Damm, n *big.Int) ([]int, error) {
+	if n.Sign() < 0 {
+		return nil, ErrNegative
+	}
+	base := uint64(d.Modulus())
+	// Divide by the largest power of base that fits in a word, then split
+	// each remainder into digits without touching the big.Int again.
+	chunk, k := base, 1
+	for chunk <= (1<<63-1)/base {
+		chunk *= base
+		k++
+	}
+	var digits []int
+	q, r, c := new(big.Int).Set(n), new(big.Int), new(big.Int).SetUint64(chunk)
+	for q.Sign() > 0 {
+		q.QuoRem(q, c, r)
+		rem := r.Uint64()
+		for range k {
+			digits = append(digits, int(rem%base))
+			rem /= base
+		}
+	}
+	for len(digits) > 0 && digits[len(digits)-1] == 0 {
+		digits = digits[:len(digits)-1]
+	}
+	slices.Reverse(digits)
+	return digits, nil
+}
+
+// GenerateBig returns the check symbol for the digit expansion of n.
+func GenerateBig(d Damm, n *big.Int) (int, error) {
+	digits, err := BigDigits(d, n)
+	if err != nil {
+		return 0, err
+	}
+	return d.Generate(digits), nil
+}
+
+// VerifyBig reports whether the least significant digit of n is the check
+// symbol for the remaining digits.
+func VerifyBig(d Damm, n *big.Int) (bool, error) {
+	digits, err := BigDigits(d, n)
+	if err != nil {
+		return false, err
+	}
+	return d.Verify(digits), nil
+}
+
+// AppendBig returns n with its check symbol appended as a new least
+// significant digit.
+func AppendBig(d Damm, n *big.Int) (*big.Int, error) {
+	check, err := GenerateBig(d, n)
+	if err != nil {
+		return nil, err
+	}
+	m := new(big.Int).Mul(n, big.NewInt(int64(d.Modulus())))
+	return m.Add(m, big.NewInt(int64(check))), nil
+}
+
+// GenerateDecimal is like GenerateBig for a number written in decimal.
+func GenerateDecimal(d Damm, s string) (int, error) {
+	n, err := parseDecimal(s)
+	if err != nil {
+		return 0, err
+	}
+	return GenerateBig(d, n)
+}
+
+// VerifyDecimal is like VerifyBig for a number written in decimal.
+func VerifyDecimal(d Damm, s string) (bool, error) {
+	n, err := parseDecimal(s)
+	if err != nil {
+		return false, err
+	}
+	return VerifyBig(d, n)
+}
+
+func parseDecimal(s string) (*big.Int, error) {
+	if s == "" {
+		return nil, ErrSyntax
+	}
+	for i := range len(s) {
+		if s[i] < '0' || s[i] > '9' {
+			return nil, ErrSyntax
+		}
+	}
+	n, _ := new(big.Int).SetString(s, 10)
+	return n, nil
+}
@@ -0,0 +1,84 @@
+package damm_test
+
+import (
+	"errors"
+	"math/big"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/go-oss/damm"
+)
+
+func TestBigDigits(t *testing.T) {
+	t.Parallel()
+	r := rand.New(rand.NewPCG(1, 2))
+	for _, d := range []damm.Damm{damm.New32(), damm.New64()} {
+		for range 100 {
+			want := make([]int, 1+r.IntN(60))
+			for i := range want {
+				want[i] = r.IntN(d.Modulus())
+			}
+			want[0] = 1 + r.IntN(d.Modulus()-1)
+			n := new(big.Int)
+			for _, digit := range want {
+				n.Mul(n, big.NewInt(int64(d.Modulus())))
+				n.Add(n, big.NewInt(int64(digit)))
+			}
+			got, err := damm.BigDigits(d, n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !equalInts(got, want) {
+				t.Fatalf("BigDigits(%v) = %v; want %v", n, got, want)
+			}
+			check, _ := damm.GenerateBig(d, n)
+			if check != d.Generate(want) {
+				t.Errorf("GenerateBig(%v) = %d; want %d", n, check, d.Generate(want))
+			}
+			m, _ := damm.AppendBig(d, n)
+			if ok, _ := damm.VerifyBig(d, m); !ok {
+				t.Errorf("VerifyBig(%v) = false; want true", m)
+			}
+			m.Add(m, big.NewInt(1))
+			if ok, _ := damm.VerifyBig(d, m); ok && check != d.Modulus()-1 {
+				t.Errorf("VerifyBig(%v) = true; want false", m)
+			}
+		}
+	}
+}
+
+func TestBigDigits_zero(t *testing.T) {
+	t.Parallel()
+	got, err := damm.BigDigits(damm.New32(), new(big.Int))
+	if err != nil || len(got) != 0 {
+		t.Errorf("BigDigits(0) = %v, %v; want [], nil", got, err)
+	}
+}
+
+func TestGenerateDecimal(t *testing.T) {
+	t.Parallel()
+	d64 := damm.New64()
+	s := "123456789012345678901234567890123"
+	n, _ := new(big.Int).SetString(s, 10)
+	want, _ := damm.GenerateBig(d64, n)
+	got, err := damm.GenerateDecimal(d64, s)
+	if err != nil || got != want {
+		t.Errorf("GenerateDecimal(%q) = %d, %v; want %d", s, got, err, want)
+	}
+	m, _ := damm.AppendBig(d64, n)
+	if ok, err := damm.VerifyDecimal(d64, m.String()); !ok || err != nil {
+		t.Errorf("VerifyDecimal(%q) = %v, %v; want true", m, ok, err)
+	}
+}
+
+func TestGenerateDecimal_errors(t *testing.T) {
+	t.Parallel()
+	for _, s := range []string{"", "-1", "+1", "12a", "1_000"} {
+		if _, err := damm.GenerateDecimal(damm.New32(), s); !errors.Is(err, damm.ErrSyntax) {
+			t.Errorf("GenerateDecimal(%q) error = %v; want ErrSyntax", s, err)
+		}
+	}
+	if _, err := damm.GenerateBig(damm.New32(), big.NewInt(-1)); !errors.Is(err, damm.ErrNegative) {
+		t.Errorf("GenerateBig(-1) error = %v; want ErrNegative", err)
+	}
+}
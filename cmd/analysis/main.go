@@ -0,0 +1,242 @@
+// Command analysis compares the check digit algorithms of package damm.
+//
+// It injects the same kinds of errors anywhere in random codes of every
+// algorithm, check symbols included, measures how many of them are
+// detected, times Generate and Verify, and prints the results as a Markdown
+// table or as JSON.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"math/rand/v2"
+	"os"
+	"testing"
+
+	"github.com/go-oss/damm"
+)
+
+type scheme struct {
+	name  string
+	c     damm.Checker
+	radix int
+	// checks returns the check symbols to append for a check value.
+	checks func(check int) []int
+}
+
+func single(check int) []int { return []int{check} }
+
+func schemes() []scheme {
+	return []scheme{
+		{"Damm 32", damm.New32(), 32, single},
+		{"Damm 36", damm.New36(), 36, single},
+		{"Damm 62", damm.New62(), 62, single},
+		{"Damm 64", damm.New64(), 64, single},
+		{"Luhn", damm.NewLuhn(), 10, single},
+		{"Verhoeff", damm.NewVerhoeff(), 10, single},
+		{"ISO 7064 MOD 11-2", damm.NewMod11_2(), 10, single},
+		{"ISO 7064 MOD 37-2", damm.NewMod37_2(), 36, single},
+		{"ISO 7064 MOD 97-10", damm.NewMod97_10(), 10, func(check int) []int { return []int{check / 10, check % 10} }},
+		{"ISO 7064 MOD 37,36", damm.NewMod37_36(), 36, single},
+	}
+}
+
+// An errorKind injects an error spanning width symbols at i into code.
+type errorKind struct {
+	name   string
+	width  int
+	inject func(r *rand.Rand, code []int, i, radix int)
+}
+
+func other(r *rand.Rand, v, radix int) int {
+	return (v + 1 + r.IntN(radix-1)) % radix
+}
+
+var errorKinds = []errorKind{
+	{"single", 1, func(r *rand.Rand, code []int, i, radix int) {
+		code[i] = other(r, code[i], radix)
+	}},
+	{"transposition", 2, func(r *rand.Rand, code []int, i, radix int) {
+		code[i], code[i+1] = code[i+1], code[i]
+	}},
+	{"jump transposition", 3, func(r *rand.Rand, code []int, i, radix int) {
+		code[i], code[i+2] = code[i+2], code[i]
+	}},
+	{"twin", 2, func(r *rand.Rand, code []int, i, radix int) {
+		code[i] = other(r, code[i], radix)
+		code[i+1] = code[i]
+	}},
+	{"jump twin", 3, func(r *rand.Rand, code []int, i, radix int) {
+		code[i] = other(r, code[i], radix)
+		code[i+2] = code[i]
+	}},
+}
+
+// setup makes payload match the precondition of kind at i, so that the
+// injected error actually changes the code.
+func setup(r *rand.Rand, kind string, payload []int, i, radix int) {
+	switch kind {
+	case "transposition":
+		payload[i+1] = other(r, payload[i], radix)
+	case "jump transposition":
+		payload[i+2] = other(r, payload[i], radix)
+	case "twin":
+		payload[i+1] = payload[i]
+	case "jump twin":
+		payload[i+2] = payload[i]
+	}
+}
+
+// applies reports whether code matches the precondition of kind at i.
+func applies(kind string, code []int, i int) bool {
+	switch kind {
+	case "transposition":
+		return code[i] != code[i+1]
+	case "jump transposition":
+		return code[i] != code[i+2]
+	case "twin":
+		return code[i] == code[i+1]
+	case "jump twin":
+		return code[i] == code[i+2]
+	}
+	return true
+}
+
+type result struct {
+	Name      string             `json:"name"`
+	Radix     int                `json:"radix"`
+	Detection map[string]float64 `json:"detection"`
+	Generate  *float64           `json:"generate_ns,omitempty"` // nil if not measured
+	Verify    *float64           `json:"verify_ns,omitempty"`
+}
+
+// detection returns the fraction of errors of kind that s detects, over
+// errors placed anywhere in the code, check symbols included. Errors
+// within the payload are set up by changing the payload; errors that touch
+// the check symbols are tried on new payloads until the check symbols match
+// the precondition.
+func detection(s scheme, kind errorKind, length, trials int, seed uint64) float64 {
+	r := rand.New(rand.NewPCG(seed, uint64(s.radix)))
+	payload := make([]int, length)
+	n := length + len(s.checks(0))
+	detected := 0
+	for range trials {
+		for {
+			for j := range payload {
+				payload[j] = r.IntN(s.radix)
+			}
+			i := r.IntN(n - kind.width + 1)
+			if i+kind.width <= length {
+				setup(r, kind.name, payload, i, s.radix)
+			}
+			code := append(payload[:length:length], s.checks(s.c.Generate(payload))...)
+			if !applies(kind.name, code, i) {
+				continue
+			}
+			kind.inject(r, code, i, s.radix)
+			if !s.c.Verify(code) {
+				detected++
+			}
+			break
+		}
+	}
+	return float64(detected) / float64(trials)
+}
+
+func timings(s scheme, length int) (generate, verify *float64) {
+	r := rand.New(rand.NewPCG(1, 2))
+	payload := make([]int, length)
+	for j := range payload {
+		payload[j] = r.IntN(s.radix)
+	}
+	code := append(payload[:length:length], s.checks(s.c.Generate(payload))...)
+	g := testing.Benchmark(func(b *testing.B) {
+		for range b.N {
+			s.c.Generate(payload)
+		}
+	})
+	v := testing.Benchmark(func(b *testing.B) {
+		for range b.N {
+			s.c.Verify(code)
+		}
+	})
+	gen := float64(g.T.Nanoseconds()) / float64(g.N)
+	ver := float64(v.T.Nanoseconds()) / float64(v.N)
+	return &gen, &ver
+}
+
+func analyze(length, trials int, seed uint64, bench bool) []result {
+	var results []result
+	for _, s := range schemes() {
+		res := result{Name: s.name, Radix: s.radix, Detection: map[string]float64{}}
+		for _, kind := range errorKinds {
+			res.Detection[kind.name] = detection(s, kind, length, trials, seed)
+		}
+		if bench {
+			res.Generate, res.Verify = timings(s, length)
+		}
+		results = append(results, res)
+	}
+	return results
+}
+
+// writeMarkdown writes results as a table, with timing columns only if
+// they were measured.
+func writeMarkdown(w io.Writer, results []result) {
+	timed := len(results) > 0 && results[0].Generate != nil
+	fmt.Fprint(w, "| Algorithm | Radix |")
+	for _, kind := range errorKinds {
+		fmt.Fprintf(w, " %s |", kind.name)
+	}
+	if timed {
+		fmt.Fprint(w, " Generate (ns/op) | Verify (ns/op) |")
+	}
+	fmt.Fprint(w, "\n|---|---:|")
+	for range errorKinds {
+		fmt.Fprint(w, "---:|")
+	}
+	if timed {
+		fmt.Fprint(w, "---:|---:|")
+	}
+	fmt.Fprintln(w)
+	for _, res := range results {
+		fmt.Fprintf(w, "| %s | %d |", res.Name, res.Radix)
+		for _, kind := range errorKinds {
+			fmt.Fprintf(w, " %.2f%% |", 100*res.Detection[kind.name])
+		}
+		if timed {
+			fmt.Fprintf(w, " %.1f | %.1f |", *res.Generate, *res.Verify)
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+func main() {
+	length := flag.Int("len", 12, "payload length (at least 3)")
+	trials := flag.Int("trials", 100000, "injected errors per algorithm and kind")
+	seed := flag.Uint64("seed", 1, "random seed")
+	bench := flag.Bool("bench", true, "measure Generate and Verify")
+	format := flag.String("format", "markdown", "output format: markdown or json")
+	flag.Parse()
+	log.SetFlags(0)
+	if *length < 3 || *trials < 1 {
+		log.Fatal("analysis: -len must be at least 3 and -trials positive")
+	}
+
+	results := analyze(*length, *trials, *seed, *bench)
+	switch *format {
+	case "markdown", "md":
+		writeMarkdown(os.Stdout, results)
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(results); err != nil {
+			log.Fatal(err)
+		}
+	default:
+		log.Fatalf("analysis: unknown format %q", *format)
+	}
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestAnalyze(t *testing.T) {
+	t.Parallel()
+	results := analyze(8, 2000, 1, false)
+	if len(results) != len(schemes()) {
+		t.Fatalf("len(results) = %d; want %d", len(results), len(schemes()))
+	}
+	for _, res := range results {
+		if got := res.Detection["single"]; got != 1 {
+			t.Errorf("%s: single error detection = %v; want 1", res.Name, got)
+		}
+		if strings.HasPrefix(res.Name, "Damm") {
+			if got := res.Detection["transposition"]; got != 1 {
+				t.Errorf("%s: transposition detection = %v; want 1", res.Name, got)
+			}
+		}
+	}
+	var buf bytes.Buffer
+	writeMarkdown(&buf, results)
+	if got, want := strings.Count(buf.String(), "\n"), len(results)+2; got != want {
+		t.Errorf("markdown has %d lines; want %d", got, want)
+	}
+	if strings.Contains(buf.String(), "ns/op") {
+		t.Errorf("markdown without timings has timing columns:\n%s", buf.String())
+	}
+	data, err := json.Marshal(results)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(data, []byte("_ns")) {
+		t.Errorf("JSON without timings has timing fields: %s", data)
+	}
+}
+
+// checkCounter always generates check symbol 0 and counts the codes it
+// verifies in which it is no longer 0.
+type checkCounter struct {
+	changed *int
+}
+
+func (checkCounter) Generate(digits []int) int { return 0 }
+
+func (c checkCounter) Verify(digits []int) bool {
+	if digits[len(digits)-1] != 0 {
+		*c.changed++
+	}
+	return false
+}
+
+func TestDetection_checkSymbols(t *testing.T) {
+	t.Parallel()
+	changed := 0
+	s := scheme{"counter", checkCounter{&changed}, 10, single}
+	detection(s, errorKinds[0], 8, 9000, 1)
+	// A single error hits the check symbol of a code of 9 symbols once in
+	// 9 times.
+	if changed < 800 || changed > 1200 {
+		t.Errorf("%d of 9000 single errors changed the check symbol; want about 1000", changed)
+	}
+}
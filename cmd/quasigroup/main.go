@@ -0,0 +1,61 @@
+// Command quasigroup prints the table of a weakly totally anti-symmetric
+// quasigroup of the given order, for use with damm.NewTable.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-oss/damm"
+)
+
+func writeGo(w io.Writer, name string, table [][]int) {
+	fmt.Fprintf(w, "var %s = [][]int{\n", name)
+	for _, row := range table {
+		s := make([]string, len(row))
+		for i, v := range row {
+			s[i] = fmt.Sprint(v)
+		}
+		fmt.Fprintf(w, "\t{%s},\n", strings.Join(s, ", "))
+	}
+	fmt.Fprintln(w, "}")
+}
+
+func main() {
+	order := flag.Int("order", 10, "order of the quasigroup")
+	timeout := flag.Duration("timeout", time.Minute, "give up searching after this long")
+	format := flag.String("format", "go", "output format: go or json")
+	name := flag.String("name", "", "variable name for -format go (default tableN)")
+	flag.Parse()
+	log.SetFlags(0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	table, err := damm.Quasigroup(ctx, *order)
+	if err != nil {
+		log.Fatalf("quasigroup: order %d: %v", *order, err)
+	}
+	if err := damm.CheckQuasigroup(table); err != nil {
+		log.Fatalf("quasigroup: order %d: %v", *order, err)
+	}
+	switch *format {
+	case "go":
+		if *name == "" {
+			*name = fmt.Sprintf("table%d", *order)
+		}
+		writeGo(os.Stdout, *name, table)
+	case "json":
+		if err := json.NewEncoder(os.Stdout).Encode(table); err != nil {
+			log.Fatal(err)
+		}
+	default:
+		log.Fatalf("quasigroup: unknown format %q", *format)
+	}
+}
@@ -0,0 +1,16 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWriteGo(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	writeGo(&buf, "table3", [][]int{{0, 1, 2}, {2, 0, 1}, {1, 2, 0}})
+	want := "var table3 = [][]int{\n\t{0, 1, 2},\n\t{2, 0, 1},\n\t{1, 2, 0},\n}\n"
+	if got := buf.String(); got != want {
+		t.Errorf("writeGo = %q; want %q", got, want)
+	}
+}
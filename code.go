@@ -0,0 +1,148 @@
+package damm
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Scheme supplies the codec of a Code type. It is usually implemented by an
+// empty struct:
+//
+//	type OrderID struct{}
+//
+//	func (OrderID) Codec() *damm.Codec { return orderCodec }
+//
+//	type Order struct {
+//		ID damm.Code[OrderID] `json:"id"`
+//	}
+type Scheme interface {
+	Codec() *Codec
+}
+
+// Code is a checked code of scheme S. Its check symbol is verified whenever it
+// is parsed, unmarshaled or scanned, so invalid codes are rejected at the
+// boundary. The zero Code is empty and maps to JSON and SQL null.
+type Code[S Scheme] struct {
+	s string
+}
+
+func codecOf[S Scheme]() *Codec {
+	var s S
+	return s.Codec()
+}
+
+// NewCode returns the code made of payload and its check symbol.
+func NewCode[S Scheme](payload string) (Code[S], error) {
+	c := codecOf[S]()
+	digits, err := c.a.Decode(payload)
+	if err != nil {
+		return Code[S]{}, err
+	}
+	digits = append(digits, c.d.Generate(digits))
+	s, _ := c.a.Encode(digits)
+	return Code[S]{s: s}, nil
+}
+
+// ParseCode verifies s and returns it as a code in canonical form.
+func ParseCode[S Scheme](s string) (Code[S], error) {
+	c := codecOf[S]()
+	digits, err := c.decode(s)
+	if err != nil {
+		return Code[S]{}, err
+	}
+	s, _ = c.a.Encode(digits)
+	return Code[S]{s: s}, nil
+}
+
+func (c Code[S]) String() string {
+	return c.s
+}
+
+// Payload returns the code without its check symbol.
+func (c Code[S]) Payload() string {
+	if c.s == "" {
+		return ""
+	}
+	return c.s[:len(c.s)-1]
+}
+
+func (c Code[S]) IsZero() bool {
+	return c.s == ""
+}
+
+// Set implements flag.Value, so codes can be validated while parsing flags
+// or environment variables. Check errors name the position where the check
+// symbol is expected.
+func (c *Code[S]) Set(s string) error {
+	err := c.parse(s)
+	var ce *CheckError
+	if errors.As(err, &ce) {
+		return fmt.Errorf("%w: expected check symbol at position %d of %d", err, ce.Offset+1, len(s))
+	}
+	return err
+}
+
+func (c Code[S]) MarshalText() ([]byte, error) {
+	return []byte(c.s), nil
+}
+
+// UnmarshalText reads empty text as the zero Code, which MarshalText
+// writes as empty text.
+func (c *Code[S]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*c = Code[S]{}
+		return nil
+	}
+	return c.parse(string(text))
+}
+
+// parse sets c to the code s, which must not be empty.
+func (c *Code[S]) parse(s string) error {
+	code, err := ParseCode[S](s)
+	if err != nil {
+		return err
+	}
+	*c = code
+	return nil
+}
+
+func (c Code[S]) MarshalJSON() ([]byte, error) {
+	if c.s == "" {
+		return []byte("null"), nil
+	}
+	return json.Marshal(c.s)
+}
+
+func (c *Code[S]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return c.parse(s)
+}
+
+func (c *Code[S]) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		*c = Code[S]{}
+		return nil
+	case string:
+		return c.parse(src)
+	case []byte:
+		return c.parse(string(src))
+	default:
+		return fmt.Errorf("damm: cannot scan %T into Code", src)
+	}
+}
+
+func (c Code[S]) Value() (driver.Value, error) {
+	if c.s == "" {
+		return nil, nil
+	}
+	return c.s, nil
+}
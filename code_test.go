@@ -0,0 +1,168 @@
+package damm_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/go-oss/damm"
+)
+
+var orderCodec, _ = damm.NewCodec(damm.New32(), damm.Crockford)
+
+type orderID struct{}
+
+func (orderID) Codec() *damm.Codec { return orderCodec }
+
+var (
+	_ encoding.TextMarshaler   = damm.Code[orderID]{}
+	_ encoding.TextUnmarshaler = (*damm.Code[orderID])(nil)
+	_ json.Marshaler           = damm.Code[orderID]{}
+	_ json.Unmarshaler         = (*damm.Code[orderID])(nil)
+	_ sql.Scanner              = (*damm.Code[orderID])(nil)
+	_ driver.Valuer            = damm.Code[orderID]{}
+)
+
+func TestCode(t *testing.T) {
+	t.Parallel()
+	code, err := damm.NewCode[orderID]("abc12")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := code.Payload(); got != "ABC12" {
+		t.Errorf("Payload() = %q; want %q", got, "ABC12")
+	}
+	parsed, err := damm.ParseCode[orderID]("abc12" + code.String()[5:])
+	if err != nil {
+		t.Fatalf("ParseCode error: %v", err)
+	}
+	if parsed != code {
+		t.Errorf("ParseCode = %q; want %q", parsed, code)
+	}
+}
+
+func TestCode_Text(t *testing.T) {
+	t.Parallel()
+	id, _ := damm.NewCode[orderID]("ORDER1")
+	for _, want := range []damm.Code[orderID]{id, {}} {
+		text, err := want.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := id
+		if err := got.UnmarshalText(text); err != nil || got != want {
+			t.Errorf("UnmarshalText(%q) = %q, %v; want %q", text, got, err, want)
+		}
+	}
+	var got damm.Code[orderID]
+	if err := got.UnmarshalText([]byte("ORDER1!")); err == nil {
+		t.Error("UnmarshalText(\"ORDER1!\") error = nil; want error")
+	}
+}
+
+func TestCode_JSON(t *testing.T) {
+	t.Parallel()
+	type order struct {
+		ID   damm.Code[orderID]   `json:"id"`
+		Prev damm.Code[orderID]   `json:"prev"`
+		Refs []damm.Code[orderID] `json:"refs"`
+	}
+	id, _ := damm.NewCode[orderID]("ORDER1")
+	ref, _ := damm.NewCode[orderID]("ORDER0")
+	data, err := json.Marshal(order{ID: id, Refs: []damm.Code[orderID]{ref}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"id":"` + id.String() + `","prev":null,"refs":["` + ref.String() + `"]}`
+	if string(data) != want {
+		t.Errorf("json.Marshal = %s; want %s", data, want)
+	}
+	var got order
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal error: %v", err)
+	}
+	if got.ID != id || !got.Prev.IsZero() || len(got.Refs) != 1 || got.Refs[0] != ref {
+		t.Errorf("json.Unmarshal = %+v", got)
+	}
+
+	bad := `{"refs":["` + ref.Payload() + `Z"]}`
+	var ce *damm.CheckError
+	if err := json.Unmarshal([]byte(bad), &got); !errors.As(err, &ce) {
+		t.Errorf("json.Unmarshal(%s) error = %v; want *CheckError", bad, err)
+	}
+	if err := json.Unmarshal([]byte(`{"id":""}`), &got); !errors.Is(err, damm.ErrNoCheck) {
+		t.Errorf("json.Unmarshal error = %v; want ErrNoCheck", err)
+	}
+}
+
+func TestCode_SQL(t *testing.T) {
+	t.Parallel()
+	id, _ := damm.NewCode[orderID]("ORDER1")
+	v, err := id.Value()
+	if err != nil || v != id.String() {
+		t.Errorf("Value() = %v, %v; want %q", v, err, id)
+	}
+	if v, _ := (damm.Code[orderID]{}).Value(); v != nil {
+		t.Errorf("Value() = %v; want nil", v)
+	}
+	for _, src := range []any{id.String(), []byte(id.String())} {
+		var got damm.Code[orderID]
+		if err := got.Scan(src); err != nil || got != id {
+			t.Errorf("Scan(%v) = %q, %v; want %q", src, got, err, id)
+		}
+	}
+	got := id
+	if err := got.Scan(nil); err != nil || !got.IsZero() {
+		t.Errorf("Scan(nil) = %q, %v; want zero", got, err)
+	}
+	var ce *damm.CheckError
+	if err := got.Scan(id.Payload() + "0"); id.String()[6] != '0' && !errors.As(err, &ce) {
+		t.Errorf("Scan error = %v; want *CheckError", err)
+	}
+	if err := got.Scan(42); err == nil {
+		t.Error("Scan(42) error = nil; want error")
+	}
+}
+
+func TestCode_flag(t *testing.T) {
+	t.Parallel()
+	id, _ := damm.NewCode[orderID]("TENANT7")
+	var got damm.Code[orderID]
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.Var(&got, "key", "tenant code")
+	if err := fs.Parse([]string{"-key", strings.ToLower(id.String())}); err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+	if got != id {
+		t.Errorf("-key = %q; want %q", got, id)
+	}
+
+	bad := id.Payload() + string(id.String()[7]^1)
+	err := fs.Parse([]string{"-key", bad})
+	if err == nil {
+		t.Fatalf("Parse(%q) error = nil; want error", bad)
+	}
+	if want := "expected check symbol at position 8 of 8"; !strings.Contains(err.Error(), want) {
+		t.Errorf("Parse(%q) error = %q; want it to contain %q", bad, err, want)
+	}
+}
+
+func TestCode_Set(t *testing.T) {
+	t.Parallel()
+	id, _ := damm.NewCode[orderID]("TENANT7")
+	var got damm.Code[orderID]
+	var ce *damm.CheckError
+	if err := got.Set(id.Payload() + string(id.String()[7]^1)); !errors.As(err, &ce) || ce.Offset != 7 {
+		t.Errorf("Set error = %v; want *CheckError at 7", err)
+	}
+	if err := got.Set(id.String()); err != nil || got != id {
+		t.Errorf("Set(%q) = %q, %v; want %q", id, got, err, id)
+	}
+}
@@ -0,0 +1,71 @@
+package damm
+
+// Codec binds a Damm to an alphabet of the same size, so that check symbols
+// can be computed and verified on strings.
+type Codec struct {
+	d Damm
+	a *Alphabet
+}
+
+func NewCodec(d Damm, a *Alphabet) (*Codec, error) {
+	if a.Len() != d.Modulus() {
+		return nil, ErrAlphabetSize
+	}
+	return &Codec{d: d, a: a}, nil
+}
+
+func (c *Codec) Damm() Damm {
+	return c.d
+}
+
+func (c *Codec) Alphabet() *Alphabet {
+	return c.a
+}
+
+// Check returns the check symbol for payload.
+func (c *Codec) Check(payload string) (byte, error) {
+	digits, err := c.a.Decode(payload)
+	if err != nil {
+		return 0, err
+	}
+	return c.a.Symbol(c.d.Generate(digits)), nil
+}
+
+// Append returns payload followed by its check symbol.
+func (c *Codec) Append(payload string) (string, error) {
+	check, err := c.Check(payload)
+	if err != nil {
+		return "", err
+	}
+	return payload + string(check), nil
+}
+
+// Verify reports whether code ends with a valid check symbol.
+func (c *Codec) Verify(code string) error {
+	_, err := c.Decode(code)
+	return err
+}
+
+// Decode verifies code and returns the values of its payload symbols.
+func (c *Codec) Decode(code string) ([]int, error) {
+	digits, err := c.decode(code)
+	if err != nil {
+		return nil, err
+	}
+	return digits[:len(digits)-1], nil
+}
+
+// decode verifies code and returns the values of all its symbols.
+func (c *Codec) decode(code string) ([]int, error) {
+	if len(code) == 0 {
+		return nil, ErrNoCheck
+	}
+	digits, err := c.a.Decode(code)
+	if err != nil {
+		return nil, err
+	}
+	if !c.d.Verify(digits) {
+		return nil, &CheckError{Offset: len(code) - 1}
+	}
+	return digits, nil
+}
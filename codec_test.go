@@ -0,0 +1,68 @@
+package damm_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-oss/damm"
+)
+
+func TestCodec(t *testing.T) {
+	t.Parallel()
+	c, err := damm.NewCodec(damm.New32(), damm.Crockford)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, payload := range []string{"", "0", "ABCD", "7ZZZ9Q1"} {
+		t.Run(payload, func(t *testing.T) {
+			code, err := c.Append(payload)
+			if err != nil {
+				t.Fatalf("Append(%q) error: %v", payload, err)
+			}
+			if err := c.Verify(code); err != nil {
+				t.Errorf("Verify(%q) error: %v", code, err)
+			}
+			v, _ := damm.Crockford.Value(code[len(code)-1])
+			bad := code[:len(code)-1] + string(damm.Crockford.Symbol((v+1)%32))
+			var ce *damm.CheckError
+			if err := c.Verify(bad); !errors.As(err, &ce) || ce.Offset != len(payload) {
+				t.Errorf("Verify(%q) error = %v; want *CheckError at %d", bad, err, len(payload))
+			}
+		})
+	}
+}
+
+func TestCodec_alphanumeric(t *testing.T) {
+	t.Parallel()
+	for _, c := range []*damm.Codec{
+		mustCodec(t, damm.New36(), damm.Base36),
+		mustCodec(t, damm.New62(), damm.Base62),
+	} {
+		code, err := c.Append("Order2024xyz")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := c.Verify(code); err != nil {
+			t.Errorf("Verify(%q) error: %v", code, err)
+		}
+		bad := code[:1] + code[2:3] + code[1:2] + code[3:]
+		if err := c.Verify(bad); err == nil {
+			t.Errorf("Verify(%q) error = nil; want error", bad)
+		}
+	}
+}
+
+func TestCodec_errors(t *testing.T) {
+	t.Parallel()
+	if _, err := damm.NewCodec(damm.New64(), damm.Crockford); !errors.Is(err, damm.ErrAlphabetSize) {
+		t.Errorf("NewCodec error = %v; want ErrAlphabetSize", err)
+	}
+	c, _ := damm.NewCodec(damm.New32(), damm.Crockford)
+	if err := c.Verify(""); !errors.Is(err, damm.ErrNoCheck) {
+		t.Errorf("Verify(\"\") error = %v; want ErrNoCheck", err)
+	}
+	var se *damm.SymbolError
+	if _, err := c.Append("AB#"); !errors.As(err, &se) {
+		t.Errorf("Append(\"AB#\") error = %v; want *SymbolError", err)
+	}
+}
@@ -0,0 +1,124 @@
+package damm_test
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/go-oss/damm"
+)
+
+// toColumns returns codes of equal length in columnar form.
+func toColumns(codes [][]int) [][]uint8 {
+	if len(codes) == 0 {
+		return nil
+	}
+	columns := make([][]uint8, len(codes[0]))
+	for j := range columns {
+		columns[j] = make([]uint8, len(codes))
+		for i, code := range codes {
+			columns[j][i] = uint8(code[j])
+		}
+	}
+	return columns
+}
+
+// equalLengthCodes returns n codes of length digits for d, about half of
+// which end with a valid check digit.
+func equalLengthCodes(d damm.Damm, n, length int) [][]int {
+	r := rand.New(rand.NewPCG(uint64(n), uint64(length)))
+	codes := make([][]int, n)
+	for i := range codes {
+		code := make([]int, length)
+		for j := range code {
+			code[j] = r.IntN(d.Modulus())
+		}
+		if length > 0 && r.IntN(2) == 0 {
+			code[length-1] = d.Generate(code[:length-1])
+		}
+		codes[i] = code
+	}
+	return codes
+}
+
+func TestVerifyColumns(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		name string
+		d    damm.Damm
+	}{
+		{"New32", damm.New32()},
+		{"New64", damm.New64()},
+		{"New36", damm.New36()},
+		{"GF(2^3)", must(damm.NewField(2, 3))},
+		{"GF(2^7)", must(damm.NewField(2, 7))},
+		{"GF(2^5) a=3", must(damm.NewField(2, 5, damm.WithMultiplier(3)))},
+		{"GF(3^2)", must(damm.NewField(3, 2))},
+	} {
+		for _, n := range []int{1, 7, 8, 9, 100, 1003} {
+			for _, length := range []int{1, 2, 12, 30} {
+				t.Run(fmt.Sprintf("%s/%dx%d", tt.name, n, length), func(t *testing.T) {
+					t.Parallel()
+					codes := equalLengthCodes(tt.d, n, length)
+					checks := damm.GenerateColumns(tt.d, toColumns(codes))
+					valid := damm.VerifyColumns(tt.d, toColumns(codes))
+					for i, code := range codes {
+						if want := tt.d.Generate(code); int(checks[i]) != want {
+							t.Errorf("GenerateColumns(...)[%d] = %d; want %d", i, checks[i], want)
+						}
+						if want := tt.d.Verify(code); valid[i] != want {
+							t.Errorf("VerifyColumns(...)[%d] = %v; want %v", i, valid[i], want)
+						}
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestVerifyColumns_empty(t *testing.T) {
+	t.Parallel()
+	if got := damm.VerifyColumns(damm.New32(), nil); got != nil {
+		t.Errorf("VerifyColumns(nil) = %v; want nil", got)
+	}
+	if got := damm.VerifyColumns(damm.New32(), [][]uint8{{}, {}}); len(got) != 0 {
+		t.Errorf("VerifyColumns(empty columns) = %v; want []", got)
+	}
+}
+
+func TestVerifyColumns_lengths(t *testing.T) {
+	t.Parallel()
+	defer func() {
+		if recover() == nil {
+			t.Error("VerifyColumns(columns of different lengths) did not panic")
+		}
+	}()
+	damm.VerifyColumns(damm.New32(), [][]uint8{{1, 2}, {3}})
+}
+
+func must(d damm.Damm, err error) damm.Damm {
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func BenchmarkVerify_rows(b *testing.B) {
+	d := damm.New32()
+	codes := equalLengthCodes(d, benchmarkBatch, 16)
+	b.ResetTimer()
+	for range b.N {
+		for _, code := range codes {
+			d.Verify(code)
+		}
+	}
+}
+
+func BenchmarkVerifyColumns(b *testing.B) {
+	d := damm.New32()
+	columns := toColumns(equalLengthCodes(d, benchmarkBatch, 16))
+	b.ResetTimer()
+	for range b.N {
+		damm.VerifyColumns(d, columns)
+	}
+}
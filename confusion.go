@@ -0,0 +1,159 @@
+package damm
+
+import "math"
+
+// ErrorModel scores the single errors that may have turned a valid code
+// into an input, for ranking suggestions.
+type ErrorModel interface {
+	// Score returns the likelihood of the error s.Edit at s.Offset that
+	// turns s.Code into input, in any unit the model uses for all errors.
+	Score(s Suggestion, input string) float64
+}
+
+// editPriors are rough relative frequencies of the kinds of Edit among
+// single errors, after Verhoeff's counts: substitutions are most of them
+// and adjacent transpositions most of the rest.
+var editPriors = [...]float64{
+	Substitution:  0.8,
+	Transposition: 0.1,
+	Insertion:     0.05,
+	Deletion:      0.05,
+}
+
+// editModel scores errors by their kind only.
+type editModel struct{}
+
+func (editModel) Score(s Suggestion, input string) float64 {
+	return editPriors[s.Edit]
+}
+
+// Confusion is an ErrorModel for symbols that are mistaken for each other,
+// such as neighboring keys or similar glyphs. It scales the frequency of
+// each kind of error by 1 if the symbols involved are confusable and by
+// Other if not:
+//
+//   - a substitution of one symbol for a confusable one,
+//   - an insertion of a symbol confusable with a neighbor, as in hitting
+//     two neighboring keys at once,
+//   - a deletion of a symbol confusable with a neighbor, as in typing a
+//     double letter once.
+//
+// Transpositions keep their frequency. Every symbol is confusable with
+// itself, and symbols are compared without regard to ASCII case.
+type Confusion struct {
+	pairs map[[2]byte]bool
+
+	// Other is the weight of errors between symbols that are not
+	// confusable, relative to those that are.
+	Other float64
+}
+
+// NewConfusion returns a Confusion in which the characters of each group
+// are confusable with each other, with Other set to 0.05.
+func NewConfusion(groups ...string) *Confusion {
+	m := &Confusion{pairs: make(map[[2]byte]bool), Other: 0.05}
+	for _, g := range groups {
+		for i := range len(g) {
+			for j := range len(g) {
+				if i != j {
+					m.pairs[[2]byte{upper(g[i]), upper(g[j])}] = true
+				}
+			}
+		}
+	}
+	return m
+}
+
+func upper(c byte) byte {
+	if 'a' <= c && c <= 'z' {
+		return c - 'a' + 'A'
+	}
+	return c
+}
+
+// Confusable reports whether a and b are confusable.
+func (m *Confusion) Confusable(a, b byte) bool {
+	a, b = upper(a), upper(b)
+	return a == b || m.pairs[[2]byte{a, b}]
+}
+
+func (m *Confusion) Score(s Suggestion, input string) float64 {
+	weight := func(ok bool) float64 {
+		if ok {
+			return 1
+		}
+		return m.Other
+	}
+	// near reports whether c is confusable with t[i-1] or t[i].
+	near := func(t string, i int, c byte) bool {
+		return i > 0 && m.Confusable(t[i-1], c) || i < len(t) && m.Confusable(t[i], c)
+	}
+	p := editPriors[s.Edit]
+	switch s.Edit {
+	case Substitution:
+		return p * weight(m.Confusable(s.Code[s.Offset], input[s.Offset]))
+	case Insertion:
+		// input[s.Offset] is extra; its neighbors in the code are at
+		// s.Offset-1 and s.Offset.
+		return p * weight(near(s.Code, s.Offset, input[s.Offset]))
+	case Deletion:
+		// s.Code[s.Offset] is missing; its neighbors in the input are at
+		// s.Offset-1 and s.Offset.
+		return p * weight(near(input, s.Offset, s.Code[s.Offset]))
+	}
+	return p
+}
+
+// keyboard returns a Confusion of neighboring keys on a keyboard with the
+// given rows, staggered as on a standard keyboard. Keys are neighbors if
+// they are next to each other in a row, or in adjacent rows less than a
+// key apart.
+func keyboard(rows ...string) *Confusion {
+	offsets := []float64{0, 0.5, 0.75, 1.25}
+	var groups []string
+	for r, row := range rows {
+		for i := range len(row) {
+			if i+1 < len(row) {
+				groups = append(groups, row[i:i+2])
+			}
+			if r+1 == len(rows) {
+				continue
+			}
+			next := rows[r+1]
+			for j := range len(next) {
+				if math.Abs(float64(i)+offsets[r]-float64(j)-offsets[r+1]) < 1 {
+					groups = append(groups, string([]byte{row[i], next[j]}))
+				}
+			}
+		}
+	}
+	return NewConfusion(groups...)
+}
+
+var (
+	// QWERTY confuses neighboring keys of a US QWERTY keyboard.
+	QWERTY = keyboard(
+		"1234567890-=",
+		"QWERTYUIOP[]",
+		"ASDFGHJKL;'",
+		"ZXCVBNM,./",
+	)
+
+	// JIS confuses neighboring keys of a Japanese JIS keyboard, typed in
+	// direct input mode. The letters are placed as on QWERTY, but the
+	// punctuation keys are not.
+	JIS = keyboard(
+		"1234567890-^\\",
+		"QWERTYUIOP@[",
+		"ASDFGHJKL;:]",
+		"ZXCVBNM,./_",
+	)
+
+	// OCR confuses characters of similar shape in print, as read by text
+	// recognition or by eye.
+	OCR = NewConfusion("0ODQ", "1IL7|", "2Z", "5S", "6G", "8B", "9G", "UV", "CG", "EF", "PR", "KX", "MN", "VY", "-_")
+
+	// Phonetic confuses characters that sound alike when read aloud in
+	// English, such as B, D, P and T.
+	Phonetic = NewConfusion("BCDEGPTVZ", "AJK8", "FSX", "IY5", "MN", "QUW", "0O")
+)
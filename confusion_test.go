@@ -0,0 +1,136 @@
+package damm_test
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/go-oss/damm"
+)
+
+func TestConfusion_Confusable(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		name string
+		m    *damm.Confusion
+		a, b byte
+		want bool
+	}{
+		{"QWERTY", damm.QWERTY, 'Q', 'W', true},
+		{"QWERTY", damm.QWERTY, 'q', 'A', true},
+		{"QWERTY", damm.QWERTY, 'G', 'B', true},
+		{"QWERTY", damm.QWERTY, 'G', 'V', true},
+		{"QWERTY", damm.QWERTY, 'G', 'N', false},
+		{"QWERTY", damm.QWERTY, 'Q', 'P', false},
+		{"QWERTY", damm.QWERTY, '0', '-', true},
+		{"JIS", damm.JIS, 'P', '@', true},
+		{"JIS", damm.JIS, '/', '_', true},
+		{"OCR", damm.OCR, '0', 'o', true},
+		{"OCR", damm.OCR, '8', 'B', true},
+		{"OCR", damm.OCR, '8', 'E', false},
+		{"Phonetic", damm.Phonetic, 'B', 'D', true},
+		{"Phonetic", damm.Phonetic, 'M', 'n', true},
+		{"Phonetic", damm.Phonetic, 'B', 'K', false},
+		{"Phonetic", damm.Phonetic, 'k', 'K', true},
+	} {
+		if got := tt.m.Confusable(tt.a, tt.b); got != tt.want {
+			t.Errorf("%s.Confusable(%q, %q) = %v; want %v", tt.name, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	t.Parallel()
+	c := mustCodec(t, damm.New36(), damm.Base36)
+	code, err := c.Append("BRAVEHEART")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		name  string
+		model damm.ErrorModel
+		input string
+		edit  damm.Edit
+	}{
+		{"OCR", damm.OCR, "8RAVEHEART" + code[10:], damm.Substitution},
+		{"QWERTY", damm.QWERTY, "BRAVEHEAFT" + code[10:], damm.Substitution},
+		{"Phonetic", damm.Phonetic, "BRAVEHEARD" + code[10:], damm.Substitution},
+	} {
+		all, err := damm.Suggest(c, tt.input, tt.model, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(all) < 2 {
+			t.Fatalf("%s: Suggest(%q) = %v; want several", tt.name, tt.input, all)
+		}
+		sum := 0.0
+		for _, s := range all {
+			sum += s.Score
+		}
+		if math.Abs(sum-1) > 1e-9 {
+			t.Errorf("%s: scores sum to %v; want 1", tt.name, sum)
+		}
+		// Other codes may be as likely, such as a confusion elsewhere that
+		// the model cannot tell from the one made, but none more likely.
+		i := slices.IndexFunc(all, func(s damm.Suggestion) bool { return s.Code == code })
+		if i < 0 || all[i].Edit != tt.edit || all[i].Score < all[0].Score-1e-9 {
+			t.Errorf("%s: Suggest(%q) = %+v; want %s by %v first", tt.name, tt.input, all, code, tt.edit)
+		}
+		plain, _ := damm.Suggest(c, tt.input, nil, 0)
+		if j := slices.IndexFunc(plain, func(s damm.Suggestion) bool { return s.Code == code }); all[i].Score <= plain[j].Score {
+			t.Errorf("%s: score of %s = %v; want more than %v without a model", tt.name, code, all[i].Score, plain[j].Score)
+		}
+		top, err := damm.Suggest(c, tt.input, tt.model, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(top) != 2 || top[0] != all[0] || top[1] != all[1] {
+			t.Errorf("%s: Suggest(%q, k=2) = %+v; want %+v", tt.name, tt.input, top, all[:2])
+		}
+	}
+}
+
+func TestIndex_Suggest(t *testing.T) {
+	t.Parallel()
+	c := mustCodec(t, damm.New64(), damm.Base64URL)
+	x, _ := newTestIndex(t, c, rand.New(rand.NewPCG(3, 4)), 500)
+	code, _ := c.Append("Zebra5")
+	if err := x.Add(code); err != nil {
+		t.Fatal(err)
+	}
+	input := "2ebra5" + code[6:]
+	all, err := damm.Suggest(c, input, damm.OCR, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := x.Suggest(input, damm.OCR, 1)
+	if len(got) != 1 || got[0].Code != code || got[0].Score <= all[0].Score {
+		t.Errorf("Suggest(%q, OCR, 1) = %+v; want %s more likely than among all codes", input, got, code)
+	}
+}
+
+func TestSuggest_foreign(t *testing.T) {
+	t.Parallel()
+	c := mustCodec(t, foreignDamm{damm.New32()}, damm.Crockford)
+	if _, err := damm.Suggest(c, "ABC", nil, 1); !errors.Is(err, damm.ErrDigest) {
+		t.Errorf("Suggest(foreign Damm) error = %v; want %v", err, damm.ErrDigest)
+	}
+}
+
+func TestSuggest_short(t *testing.T) {
+	t.Parallel()
+	c := mustCodec(t, damm.New32(), damm.Crockford)
+	for _, input := range []string{"", "5", "0", "!"} {
+		got, err := damm.Suggest(c, input, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, s := range got {
+			if err := c.Verify(s.Code); err != nil {
+				t.Errorf("Suggest(%q) suggests %+v, which fails Verify: %v", input, s, err)
+			}
+		}
+	}
+}
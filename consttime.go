@@ -0,0 +1,79 @@
+package damm
+
+import (
+	"crypto/subtle"
+	"errors"
+)
+
+// ErrConstantTime is returned when WithConstantTime is used with a
+// construction that has no constant-time implementation.
+var ErrConstantTime = errors.New("damm: constant time is only supported over GF(2^n)")
+
+// ctDamm is calculate without data-dependent branches or memory accesses,
+// for any multiplier a.
+type ctDamm struct {
+	n    int
+	mask int
+	a    int
+}
+
+// mul returns a·x, adding shifted copies of x under masks instead of
+// branching on the bits of a and reducing under a mask taken from the
+// carry bit.
+func (d *ctDamm) mul(x int) (z int) {
+	for i := range d.n {
+		z ^= x & -(d.a >> i & 1)
+		x <<= 1
+		x ^= d.mask & -(x >> d.n)
+	}
+	return z
+}
+
+func (d *ctDamm) Generate(digits []int) (checkDigit int) {
+	// Bits above n of any digit, including the sign bits of a negative
+	// one, are collected without branching and checked once at the end.
+	high := 0
+	for _, digit := range digits {
+		high |= digit >> d.n
+		checkDigit = d.mul((checkDigit ^ digit) & (1<<d.n - 1))
+	}
+	// The top bit of high|-high is set unless high is 0.
+	inRange := int(^(uint64(high) | -uint64(high)) >> 63)
+	return subtle.ConstantTimeSelect(inRange, checkDigit, -1)
+}
+
+func (d *ctDamm) Verify(digits []int) bool {
+	return subtle.ConstantTimeEq(int32(d.Generate(digits)), 0) == 1
+}
+
+func (d *ctDamm) Modulus() int {
+	return 1 << d.n
+}
+
+// decodeConstantTime is like Decode, but reads the whole value table for
+// every character instead of indexing it, and does not stop at invalid
+// characters. It returns 1 if all characters are valid and 0 otherwise.
+func (a *Alphabet) decodeConstantTime(s string) (digits []int, valid int) {
+	digits = make([]int, len(s))
+	valid = 1
+	for i := range len(s) {
+		v := 0 // value+1, or 0 if invalid
+		for c, w := range a.values {
+			v = subtle.ConstantTimeSelect(subtle.ConstantTimeByteEq(byte(c), s[i]), int(w)+1, v)
+		}
+		invalid := subtle.ConstantTimeEq(int32(v), 0)
+		valid &= 1 ^ invalid
+		digits[i] = v - 1 + invalid
+	}
+	return digits, valid
+}
+
+// VerifyConstantTime reports whether code ends with a valid check symbol,
+// in time that depends only on len(code) if the Damm of c was made with
+// WithConstantTime. Unlike Verify it does not say what is wrong.
+func (c *Codec) VerifyConstantTime(code string) bool {
+	digits, valid := c.a.decodeConstantTime(code)
+	check := c.d.Generate(digits)
+	valid &= subtle.ConstantTimeEq(int32(check), 0)
+	return valid == 1 && len(code) > 0
+}
@@ -0,0 +1,153 @@
+package damm_test
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-oss/damm"
+)
+
+func TestWithConstantTime(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		name string
+		n    int
+		opts []damm.Option
+	}{
+		{"GF(2^3)", 3, nil},
+		{"GF(2^5)", 5, nil},
+		{"GF(2^5) a=3", 5, []damm.Option{damm.WithMultiplier(3)}},
+		{"GF(2^6)", 6, nil},
+		{"GF(2^6) a=37", 6, []damm.Option{damm.WithMultiplier(37)}},
+		{"GF(2^8)", 8, nil},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := rand.New(rand.NewPCG(1, 2))
+			d, err := damm.NewField(2, tt.n, tt.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ct, err := damm.NewField(2, tt.n, append(tt.opts, damm.WithConstantTime())...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for range 1000 {
+				digits := make([]int, r.IntN(20))
+				for i := range digits {
+					digits[i] = r.IntN(d.Modulus())
+				}
+				want := d.Generate(digits)
+				if got := ct.Generate(digits); got != want {
+					t.Fatalf("Generate(%v) = %d; want %d", digits, got, want)
+				}
+				if got := ct.Verify(append(digits, want)); !got {
+					t.Fatalf("Verify(%v) = false; want true", append(digits, want))
+				}
+			}
+		})
+	}
+}
+
+func TestWithConstantTime_odd(t *testing.T) {
+	t.Parallel()
+	if _, err := damm.NewField(3, 2, damm.WithConstantTime()); !errors.Is(err, damm.ErrConstantTime) {
+		t.Errorf("NewField(3, 2, WithConstantTime()) error = %v; want %v", err, damm.ErrConstantTime)
+	}
+}
+
+func TestCodec_VerifyConstantTime(t *testing.T) {
+	t.Parallel()
+	c := mustCodec(t, damm.New32(damm.WithConstantTime()), damm.Crockford)
+	for _, code := range []string{"", "0", "1", "C0FFEE", "c0ffee", "01234567", "0123456U", "0I23", "Z", "ZZZZZZZZZZZZ"} {
+		want := c.Verify(code) == nil
+		if got := c.VerifyConstantTime(code); got != want {
+			t.Errorf("VerifyConstantTime(%q) = %v; want %v", code, got, want)
+		}
+		if s, err := c.Append(code); err == nil {
+			if !c.VerifyConstantTime(s) {
+				t.Errorf("VerifyConstantTime(%q) = false; want true", s)
+			}
+		}
+	}
+}
+
+// TestCodec_VerifyConstantTime_timing compares the running times of
+// VerifyConstantTime on a valid key, a key with a wrong check symbol and a
+// key with an invalid first character with Welch's t-test, in the manner of
+// dudect. Measurements of the classes are interleaved so that drift in the
+// machine affects them alike, and the threshold is generous because the
+// test runs on shared machines.
+func TestCodec_VerifyConstantTime_timing(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping timing test in short mode")
+	}
+	c := mustCodec(t, damm.New32(damm.WithConstantTime()), damm.Crockford)
+	valid, err := c.Append("7K3QZ0M9TP4XW2HD8RVA1N6C5B")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wrong := valid[:len(valid)-1] + string(valid[len(valid)-1]^1)
+	invalid := "U" + valid[1:]
+	for _, other := range []string{wrong, invalid} {
+		if tt := welch(measure(c, valid, other)); math.Abs(tt) > 10 {
+			t.Errorf("VerifyConstantTime(%q) vs (%q): |t| = %.1f; want <= 10", valid, other, tt)
+		}
+	}
+}
+
+// measure returns the running times of batches of calls to
+// VerifyConstantTime on a and b, in random order.
+func measure(c *damm.Codec, a, b string) (x, y []float64) {
+	const samples, batch = 1000, 32
+	r := rand.New(rand.NewPCG(3, 4))
+	for range 2 * samples {
+		code, dst := a, &x
+		if r.IntN(2) == 1 {
+			code, dst = b, &y
+		}
+		start := time.Now()
+		for range batch {
+			c.VerifyConstantTime(code)
+		}
+		*dst = append(*dst, float64(time.Since(start)))
+	}
+	return crop(x), crop(y)
+}
+
+// crop drops samples above the 90th percentile of xs, which are mostly
+// interrupts and preemptions.
+func crop(xs []float64) []float64 {
+	sorted := append([]float64(nil), xs...)
+	slices.Sort(sorted)
+	limit := sorted[len(sorted)*9/10]
+	var out []float64
+	for _, x := range xs {
+		if x <= limit {
+			out = append(out, x)
+		}
+	}
+	return out
+}
+
+// welch returns Welch's t statistic for the means of x and y.
+func welch(x, y []float64) float64 {
+	mx, vx := meanVar(x)
+	my, vy := meanVar(y)
+	return (mx - my) / math.Sqrt(vx/float64(len(x))+vy/float64(len(y)))
+}
+
+func meanVar(xs []float64) (mean, variance float64) {
+	for _, x := range xs {
+		mean += x
+	}
+	mean /= float64(len(xs))
+	for _, x := range xs {
+		variance += (x - mean) * (x - mean)
+	}
+	return mean, variance / float64(len(xs)-1)
+}
@@ -1,13 +1,28 @@
 package damm
 
-type Damm interface {
+// Checker computes and verifies check symbols. Generate returns the check
+// symbol for digits and Verify reports whether digits end with a valid one.
+type Checker interface {
 	Generate(digits []int) int
 	Verify(digits []int) bool
+}
+
+// Damm is a Checker over the digits [0, Modulus()). For a digit outside
+// that range, Generate returns -1 and Verify returns false.
+type Damm interface {
+	Checker
 	Modulus() int
 }
 
+// calculate folds digits with x∘y = X·(x+y) in GF(2^n) = GF(2)[X]/(mask),
+// where modulus = 2^n. It is the binary case of fieldDamm, with addition as
+// XOR and multiplication by X as a shift. It returns -1 for a digit
+// outside [0, modulus).
 func calculate(digits []int, modulus, mask int) (checkDigit int) {
 	for _, digit := range digits {
+		if uint(digit) >= uint(modulus) {
+			return -1
+		}
 		checkDigit ^= digit
 		checkDigit <<= 1
 		if checkDigit >= modulus {
@@ -34,18 +49,45 @@ func (d *damm) Modulus() int {
 	return d.modulus
 }
 
-func New32() Damm {
-	const modulus = 32
-	return &damm{
-		modulus: modulus,
-		mask:    modulus | 5,
+// New32 returns the Damm over GF(2^5) = GF(2)[X]/(X^5+X^2+1), the same as
+// NewField(2, 5). It panics if an option is invalid.
+func New32(opts ...Option) Damm {
+	return mustField(2, 5, opts)
+}
+
+// New64 returns the Damm over GF(2^6) = GF(2)[X]/(X^6+X+1), the same as
+// NewField(2, 6). It panics if an option is invalid.
+func New64(opts ...Option) Damm {
+	return mustField(2, 6, opts)
+}
+
+func mustField(p, n int, opts []Option) Damm {
+	d, err := NewField(p, n, opts...)
+	if err != nil {
+		panic(err)
 	}
+	return d
+}
+
+// New36 returns a Damm of order 36, for alphanumeric codes such as Base36.
+// It panics if an option is invalid. With WithLengthBinding it detects only
+// up to 5 inserted or deleted leading zeros, as its cycle has length 6.
+func New36(opts ...Option) Damm {
+	return mustTable(table36, opts)
+}
+
+// New62 returns a Damm of order 62, for case-sensitive alphanumeric codes
+// such as Base62. It panics if an option is invalid. With WithLengthBinding
+// it detects only up to 15 inserted or deleted leading zeros, as its cycle
+// has length 16.
+func New62(opts ...Option) Damm {
+	return mustTable(table62, opts)
 }
 
-func New64() Damm {
-	const modulus = 64
-	return &damm{
-		modulus: modulus,
-		mask:    modulus | 3,
+func mustTable(table [][]int, opts []Option) Damm {
+	d, err := newTable(table, opts)
+	if err != nil {
+		panic(err)
 	}
+	return d
 }
@@ -1,7 +1,10 @@
 package damm_test
 
 import (
+	"context"
 	"fmt"
+	"reflect"
+	"slices"
 	"testing"
 
 	"github.com/go-oss/damm"
@@ -104,6 +107,65 @@ func TestDamm32_matrix(t *testing.T) {
 	assertWeaklyTotallyAntiSymmetric(t, m, d32.Modulus())
 }
 
+func TestDamm32_multiplier(t *testing.T) {
+	t.Parallel()
+	for a := 2; a < 32; a++ {
+		t.Run(fmt.Sprint(a), func(t *testing.T) {
+			t.Parallel()
+			d32 := damm.New32(damm.WithMultiplier(a))
+			assertWeaklyTotallyAntiSymmetric(t, genMatrix(d32), d32.Modulus())
+		})
+	}
+}
+
+func TestDamm64_multiplier(t *testing.T) {
+	t.Parallel()
+	for _, a := range []int{2, 3, 7, 35, 63} {
+		t.Run(fmt.Sprint(a), func(t *testing.T) {
+			t.Parallel()
+			d64 := damm.New64(damm.WithMultiplier(a))
+			assertWeaklyTotallyAntiSymmetric(t, genMatrix(d64), d64.Modulus())
+		})
+	}
+	if got, want := damm.New64(damm.WithMultiplier(2)).Generate(testDigits64), damm.New64().Generate(testDigits64); got != want {
+		t.Errorf("New64(WithMultiplier(2)).Generate = %d; want %d", got, want)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("New64(WithMultiplier(1)) did not panic")
+		}
+	}()
+	damm.New64(damm.WithMultiplier(1))
+}
+
+func TestDamm36_matrix(t *testing.T) {
+	t.Parallel()
+	d36 := damm.New36()
+	m := genMatrix(d36)
+	assertWeaklyTotallyAntiSymmetric(t, m, d36.Modulus())
+	assertGeneratedTable(t, m)
+}
+
+func TestDamm62_matrix(t *testing.T) {
+	t.Parallel()
+	d62 := damm.New62()
+	m := genMatrix(d62)
+	assertWeaklyTotallyAntiSymmetric(t, m, d62.Modulus())
+	assertGeneratedTable(t, m)
+}
+
+// assertGeneratedTable checks that m is still what cmd/quasigroup prints.
+func assertGeneratedTable(t *testing.T, m [][]int) {
+	t.Helper()
+	want, err := damm.Quasigroup(context.Background(), len(m))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(m, want) {
+		t.Errorf("table of order %d differs from Quasigroup(%d)", len(m), len(m))
+	}
+}
+
 func TestDamm64_Generate(t *testing.T) {
 	t.Parallel()
 	d64 := damm.New64()
@@ -233,3 +295,45 @@ func BenchmarkDamm64(b *testing.B) {
 		d64.Generate(testDigits64)
 	}
 }
+
+func TestDamm_outOfRange(t *testing.T) {
+	t.Parallel()
+	table10, err := damm.Quasigroup(context.Background(), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		name string
+		d    damm.Damm
+	}{
+		{"New32", damm.New32()},
+		{"New64", damm.New64()},
+		{"New32 multiplier", damm.New32(damm.WithMultiplier(3))},
+		{"New64 multiplier", damm.New64(damm.WithMultiplier(3))},
+		{"New32 constant time", damm.New32(damm.WithConstantTime())},
+		{"NewField(2, 8) constant time", must(damm.NewField(2, 8, damm.WithConstantTime()))},
+		{"NewField(7, 2)", must(damm.NewField(7, 2))},
+		{"NewField(7, 2) multiplier", must(damm.NewField(7, 2, damm.WithMultiplier(3)))},
+		{"NewField(3, 1)", must(damm.NewField(3, 1))},
+		{"New36", damm.New36()},
+		{"New62", damm.New62()},
+		{"NewTable", must(damm.NewTable(table10))},
+		{"New32 length binding", damm.New32(damm.WithLengthBinding())},
+		{"New36 length binding", damm.New36(damm.WithLengthBinding())},
+		{"NewField(7, 2) domain", must(damm.NewField(7, 2, damm.WithDomain("user")))},
+	} {
+		q := tt.d.Modulus()
+		// {40, 117} verifies under New32 if digits are not checked.
+		for _, digits := range [][]int{{q}, {-1}, {q + 8}, {40, 117}, {1, 2, q + 1}, {1 << 40}, {-1 << 40, 0}} {
+			if !slices.ContainsFunc(digits, func(v int) bool { return v < 0 || v >= q }) {
+				continue
+			}
+			if got := tt.d.Generate(digits); got != -1 {
+				t.Errorf("%s: Generate(%v) = %d; want -1", tt.name, digits, got)
+			}
+			if tt.d.Verify(digits) {
+				t.Errorf("%s: Verify(%v) = true; want false", tt.name, digits)
+			}
+		}
+	}
+}
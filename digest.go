@@ -0,0 +1,177 @@
+package damm
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"math/bits"
+)
+
+var (
+	// ErrDigest is returned by NewDigest and UpdateCheckSuffix for Damms
+	// that were not made by this package.
+	ErrDigest = errors.New("damm: Damm does not support digests")
+
+	// ErrDigestMismatch is returned when restoring a digest from the state
+	// of a digest over a different Damm.
+	ErrDigestMismatch = errors.New("damm: digest state is for a different Damm")
+
+	errDigestState = errors.New("damm: invalid digest state")
+)
+
+// digestMagic starts every marshaled digest state.
+const digestMagic = "damm\x01"
+
+// stepper is implemented by the Damms of this package, whose interim digit
+// can be advanced one digit at a time.
+type stepper interface {
+	Damm
+
+	// step returns the interim digit after digit, given the previous one.
+	step(interim, digit int) int
+
+	// appendIdentity appends bytes that identify the quasigroup, so that
+	// two steppers with the same identity compute the same check digits.
+	appendIdentity(b []byte) []byte
+}
+
+// Digest computes a check digit incrementally, for payloads that arrive in
+// pieces. Its state can be saved with MarshalBinary and restored into a
+// digest over the same Damm with UnmarshalBinary, possibly in another
+// process.
+type Digest struct {
+	d       stepper
+	seed    int
+	interim int
+}
+
+// NewDigest returns a digest over d with no digits written.
+func NewDigest(d Damm) (*Digest, error) {
+	s, ok := d.(stepper)
+	if !ok {
+		return nil, ErrDigest
+	}
+	seed := d.Generate(nil)
+	return &Digest{d: s, seed: seed, interim: seed}, nil
+}
+
+func (g *Digest) Damm() Damm {
+	return g.d
+}
+
+// Write adds digits to the payload.
+func (g *Digest) Write(digits ...int) {
+	for _, digit := range digits {
+		g.interim = g.d.step(g.interim, digit)
+	}
+}
+
+// Check returns the check digit for the digits written so far, which is
+// d.Generate of all of them.
+func (g *Digest) Check() int {
+	return g.interim
+}
+
+// Valid reports whether the digits written so far end with a valid check
+// digit.
+func (g *Digest) Valid() bool {
+	return g.interim == 0
+}
+
+// Reset forgets the digits written.
+func (g *Digest) Reset() {
+	g.interim = g.seed
+}
+
+// AppendBinary appends the state of g to b, as MarshalBinary.
+func (g *Digest) AppendBinary(b []byte) ([]byte, error) {
+	b = append(b, digestMagic...)
+	b = g.d.appendIdentity(b)
+	return binary.AppendUvarint(b, uint64(g.interim)), nil
+}
+
+// MarshalBinary returns the state of g, which includes the identity of its
+// Damm.
+func (g *Digest) MarshalBinary() ([]byte, error) {
+	return g.AppendBinary(nil)
+}
+
+// UnmarshalBinary restores a state returned by MarshalBinary. It returns
+// ErrDigestMismatch if the state was saved from a digest over a different
+// Damm, and leaves g unchanged on error.
+func (g *Digest) UnmarshalBinary(b []byte) error {
+	rest, ok := bytes.CutPrefix(b, []byte(digestMagic))
+	if !ok {
+		return errDigestState
+	}
+	if rest, ok = bytes.CutPrefix(rest, g.d.appendIdentity(nil)); !ok {
+		return ErrDigestMismatch
+	}
+	interim, n := binary.Uvarint(rest)
+	if n <= 0 || n != len(rest) || interim >= uint64(g.d.Modulus()) {
+		return errDigestState
+	}
+	g.interim = int(interim)
+	return nil
+}
+
+// appendFieldIdentity appends the identity of the field construction over
+// GF(p^n) = GF(p)[X]/(f) with multiplier a, where poly holds the
+// coefficients of f as a base p number.
+func appendFieldIdentity(b []byte, p, n, poly, a int) []byte {
+	b = append(b, 'F')
+	for _, v := range []int{p, n, poly, a} {
+		b = binary.AppendUvarint(b, uint64(v))
+	}
+	return b
+}
+
+func (d *damm) step(interim, digit int) int {
+	interim ^= digit
+	interim <<= 1
+	if interim >= d.modulus {
+		interim ^= d.mask
+	}
+	return interim
+}
+
+func (d *damm) appendIdentity(b []byte) []byte {
+	return appendFieldIdentity(b, 2, bits.Len(uint(d.modulus))-1, d.mask, 2)
+}
+
+func (d *ctDamm) step(interim, digit int) int {
+	return d.mul(interim ^ digit)
+}
+
+func (d *ctDamm) appendIdentity(b []byte) []byte {
+	return appendFieldIdentity(b, 2, d.n, d.mask, d.a)
+}
+
+func (d *fieldDamm) step(interim, digit int) int {
+	return d.mul[d.f.sub(interim, digit)]
+}
+
+func (d *fieldDamm) appendIdentity(b []byte) []byte {
+	return appendFieldIdentity(b, d.f.p, d.f.n, d.f.q+d.f.element(d.f.poly), d.mul[1])
+}
+
+func (d *tableDamm) step(interim, digit int) int {
+	return d.table[interim][digit]
+}
+
+// appendIdentity appends the order and a SHA-256 of the table, as the
+// table has no shorter description.
+func (d *tableDamm) appendIdentity(b []byte) []byte {
+	h := sha256.New()
+	var buf []byte
+	for _, row := range d.table {
+		for _, v := range row {
+			buf = binary.AppendUvarint(buf[:0], uint64(v))
+			h.Write(buf)
+		}
+	}
+	b = append(b, 'T')
+	b = binary.AppendUvarint(b, uint64(len(d.table)))
+	return h.Sum(b)
+}
@@ -0,0 +1,168 @@
+package damm_test
+
+import (
+	"encoding"
+	"errors"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/go-oss/damm"
+)
+
+var (
+	_ encoding.BinaryMarshaler   = (*damm.Digest)(nil)
+	_ encoding.BinaryUnmarshaler = (*damm.Digest)(nil)
+)
+
+func mustDigest(t testing.TB, d damm.Damm) *damm.Digest {
+	t.Helper()
+	g, err := damm.NewDigest(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func TestDigest(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		name string
+		d    damm.Damm
+	}{
+		{"New32", damm.New32()},
+		{"New64", damm.New64()},
+		{"New32 constant time", damm.New32(damm.WithConstantTime())},
+		{"New36", damm.New36()},
+		{"New62", damm.New62()},
+		{"New36 length binding", damm.New36(damm.WithLengthBinding())},
+		{"GF(3^2)", must(damm.NewField(3, 2))},
+		{"GF(5^2) a=3", must(damm.NewField(5, 2, damm.WithMultiplier(3)))},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := rand.New(rand.NewPCG(1, 2))
+			digits := make([]int, 50)
+			for i := range digits {
+				digits[i] = r.IntN(tt.d.Modulus())
+			}
+			g := mustDigest(t, tt.d)
+			for i, digit := range digits {
+				g.Write(digit)
+				if got, want := g.Check(), tt.d.Generate(digits[:i+1]); got != want {
+					t.Fatalf("Check() after %d digits = %d; want %d", i+1, got, want)
+				}
+			}
+
+			// Resume halfway in a fresh digest.
+			g.Reset()
+			g.Write(digits[:20]...)
+			state, err := g.MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+			h := mustDigest(t, tt.d)
+			if err := h.UnmarshalBinary(state); err != nil {
+				t.Fatalf("UnmarshalBinary(%x) error: %v", state, err)
+			}
+			h.Write(digits[20:]...)
+			check := tt.d.Generate(digits)
+			if h.Check() != check {
+				t.Errorf("Check() after resume = %d; want %d", h.Check(), check)
+			}
+			if h.Valid() != (check == 0) {
+				t.Errorf("Valid() = %v; want %v", h.Valid(), check == 0)
+			}
+			h.Write(check)
+			if !h.Valid() {
+				t.Error("Valid() after check digit = false; want true")
+			}
+		})
+	}
+}
+
+func TestDigest_UnmarshalBinary_mismatch(t *testing.T) {
+	t.Parallel()
+	damms := []damm.Damm{
+		damm.New32(),
+		damm.New64(),
+		damm.New32(damm.WithMultiplier(3)),
+		damm.New32(damm.WithLengthBinding()),
+		damm.New36(),
+		damm.New62(),
+		must(damm.NewField(3, 2)),
+		must(damm.NewField(3, 2, damm.WithMultiplier(5))),
+		must(damm.NewField(2, 3)),
+	}
+	for i, d := range damms {
+		g := mustDigest(t, d)
+		g.Write(1)
+		state, err := g.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for j, e := range damms {
+			h := mustDigest(t, e)
+			err := h.UnmarshalBinary(state)
+			if i == j && err != nil {
+				t.Errorf("damms[%d].UnmarshalBinary(own state) error: %v", i, err)
+			}
+			if i != j && !errors.Is(err, damm.ErrDigestMismatch) {
+				t.Errorf("damms[%d].UnmarshalBinary(state of damms[%d]) error = %v; want %v", j, i, err, damm.ErrDigestMismatch)
+			}
+		}
+	}
+}
+
+func TestDigest_UnmarshalBinary_equivalent(t *testing.T) {
+	t.Parallel()
+	g := mustDigest(t, damm.New32())
+	g.Write(7, 3, 31)
+	state, err := g.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range []damm.Damm{must(damm.NewField(2, 5)), damm.New32(damm.WithConstantTime())} {
+		h := mustDigest(t, d)
+		if err := h.UnmarshalBinary(state); err != nil {
+			t.Errorf("UnmarshalBinary(state of New32) error: %v", err)
+		}
+		if h.Check() != g.Check() {
+			t.Errorf("Check() = %d; want %d", h.Check(), g.Check())
+		}
+	}
+}
+
+func TestDigest_UnmarshalBinary_invalid(t *testing.T) {
+	t.Parallel()
+	g := mustDigest(t, damm.New32())
+	g.Write(5)
+	state, err := g.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, b := range [][]byte{
+		nil,
+		[]byte("damm"),
+		state[:len(state)-1],
+		append(state, 0),
+		append(state[:len(state)-1:len(state)-1], 32),
+	} {
+		h := mustDigest(t, damm.New32())
+		h.Write(9)
+		if err := h.UnmarshalBinary(b); err == nil {
+			t.Errorf("UnmarshalBinary(%x) error = nil", b)
+		}
+		if h.Check() != damm.New32().Generate([]int{9}) {
+			t.Errorf("UnmarshalBinary(%x) changed the digest", b)
+		}
+	}
+}
+
+type foreignDamm struct{ damm.Damm }
+
+func TestNewDigest_foreign(t *testing.T) {
+	t.Parallel()
+	if _, err := damm.NewDigest(foreignDamm{damm.New32()}); !errors.Is(err, damm.ErrDigest) {
+		t.Errorf("NewDigest(foreign Damm) error = %v; want %v", err, damm.ErrDigest)
+	}
+}
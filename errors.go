@@ -0,0 +1,57 @@
+package damm
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrAlphabetSize is returned when an alphabet does not have exactly
+	// Modulus() symbols.
+	ErrAlphabetSize = errors.New("damm: alphabet size does not match modulus")
+
+	// ErrNoCheck is returned for codes too short to carry a check symbol.
+	ErrNoCheck = errors.New("damm: code has no check symbol")
+)
+
+// SymbolError reports a character that is not part of the alphabet.
+type SymbolError struct {
+	Offset int
+	Symbol byte
+}
+
+func (e *SymbolError) Error() string {
+	return fmt.Sprintf("damm: invalid symbol %q at offset %d", e.Symbol, e.Offset)
+}
+
+// CheckError reports a code whose check symbol does not match its payload.
+type CheckError struct {
+	Offset int // offset of the check symbol
+}
+
+func (e *CheckError) Error() string {
+	return fmt.Sprintf("damm: check symbol mismatch at offset %d", e.Offset)
+}
+
+// OverflowError reports a number that does not fit in the available symbols.
+type OverflowError struct {
+	Width int
+}
+
+func (e *OverflowError) Error() string {
+	return fmt.Sprintf("damm: value does not fit in %d symbols", e.Width)
+}
+
+// WidthError reports an invalid width or an input whose length does not
+// match the width.
+type WidthError struct {
+	Width int
+	Len   int // length of the payload, or -1 if Width itself is invalid
+}
+
+func (e *WidthError) Error() string {
+	if e.Len < 0 {
+		return fmt.Sprintf("damm: invalid width %d", e.Width)
+	}
+	return fmt.Sprintf("damm: payload has %d symbols; want %d", e.Len, e.Width)
+}
@@ -0,0 +1,263 @@
+package damm
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrMultiplier is returned for multipliers that are not field elements
+// other than 0 and 1.
+var ErrMultiplier = errors.New("damm: multiplier must be a field element other than 0 and 1")
+
+// maxFieldOrder bounds the order of NewField, whose multiplication table
+// has one entry per element.
+const maxFieldOrder = 1 << 16
+
+// field is GF(p^n) with elements 0..p^n-1, whose base p digits are the
+// coefficients of polynomials modulo a monic irreducible polynomial.
+type field struct {
+	p, n, q int
+	poly    []int // coefficients of X^0..X^(n-1) of the modulus; X^n is implied
+}
+
+func newField(p, n int) (*field, error) {
+	if p < 2 || !isPrime(p) {
+		return nil, fmt.Errorf("damm: characteristic %d is not prime", p)
+	}
+	if n < 1 {
+		return nil, fmt.Errorf("damm: invalid field degree %d", n)
+	}
+	f := &field{p: p, n: n, q: 1}
+	for range n {
+		if f.q > (1<<31-1)/p {
+			return nil, fmt.Errorf("damm: field of order %d^%d is too large", p, n)
+		}
+		f.q *= p
+	}
+	for c := range f.q {
+		f.poly = f.digits(c)
+		if irreducible(append(f.digits(c), 1), p) {
+			return f, nil
+		}
+	}
+	panic("unreachable")
+}
+
+// NewField returns a Damm over GF(p^n) for a prime p, with interim digits
+// x∘y = a·(x-y), where a is X for n > 1 and 2 for n = 1 unless set with
+// WithMultiplier. Symbols are the elements of GF(p)[X]/(f) for the first
+// monic irreducible f of degree n, written as base p numbers. NewField(2, 5)
+// and NewField(2, 6) are New32 and New64.
+//
+// For a ≠ 0, 1 the rows and columns of x∘y are permutations and x∘x = 0.
+// (c∘x)∘y = (c∘y)∘x means a(1-a)(x-y) = 0, so x = y, which makes the
+// quasigroup weakly totally anti-symmetric. GF(2) has no such a.
+func NewField(p, n int, opts ...Option) (Damm, error) {
+	f, err := newField(p, n)
+	if err != nil {
+		return nil, err
+	}
+	if f.q < 3 {
+		return nil, ErrOrder
+	}
+	if f.q > maxFieldOrder {
+		return nil, fmt.Errorf("damm: field of order %d^%d is too large", p, n)
+	}
+	o := newOptions(opts)
+	a := 2
+	if n > 1 {
+		a = p
+	}
+	if o.multiplier != nil {
+		a = *o.multiplier
+		if a < 2 || a >= f.q {
+			return nil, ErrMultiplier
+		}
+	}
+	mask := f.q | f.element(f.poly)
+	switch {
+	case o.constantTime && p == 2:
+		return o.applySeed(&ctDamm{n: n, mask: mask, a: a})
+	case o.constantTime:
+		return nil, ErrConstantTime
+	case p == 2 && a == 2:
+		return o.applySeed(&damm{modulus: f.q, mask: mask})
+	}
+	return o.applySeed(newFieldDamm(f, a))
+}
+
+type fieldDamm struct {
+	f   *field
+	mul []int // mul[x] = a·x
+}
+
+func newFieldDamm(f *field, a int) *fieldDamm {
+	d := &fieldDamm{f: f, mul: make([]int, f.q)}
+	for x := range f.q {
+		d.mul[x] = f.mul(a, x)
+	}
+	return d
+}
+
+func (d *fieldDamm) Generate(digits []int) (checkDigit int) {
+	for _, digit := range digits {
+		if uint(digit) >= uint(d.f.q) {
+			return -1
+		}
+		checkDigit = d.mul[d.f.sub(checkDigit, digit)]
+	}
+	return checkDigit
+}
+
+func (d *fieldDamm) Verify(digits []int) bool {
+	return d.Generate(digits) == 0
+}
+
+func (d *fieldDamm) Modulus() int {
+	return d.f.q
+}
+
+func isPrime(n int) bool {
+	for i := 2; i*i <= n; i++ {
+		if n%i == 0 {
+			return false
+		}
+	}
+	return n >= 2
+}
+
+// primePower returns p and k with n = p^k, or ok = false.
+func primePower(n int) (p, k int, ok bool) {
+	for p = 2; p <= n; p++ {
+		if n%p == 0 {
+			break
+		}
+	}
+	for ; n%p == 0; n /= p {
+		k++
+	}
+	return p, k, n == 1 && k > 0
+}
+
+func (f *field) digits(x int) []int {
+	d := make([]int, f.n)
+	for i := range d {
+		d[i] = x % f.p
+		x /= f.p
+	}
+	return d
+}
+
+func (f *field) element(d []int) (x int) {
+	for i := len(d) - 1; i >= 0; i-- {
+		x = x*f.p + d[i]
+	}
+	return x
+}
+
+func (f *field) add(x, y int) (z int) {
+	if f.p == 2 {
+		return x ^ y
+	}
+	for w := 1; w < f.q; w *= f.p {
+		z += (x%f.p + y%f.p) % f.p * w
+		x, y = x/f.p, y/f.p
+	}
+	return z
+}
+
+func (f *field) sub(x, y int) (z int) {
+	if f.p == 2 {
+		return x ^ y
+	}
+	for w := 1; w < f.q; w *= f.p {
+		z += (x%f.p - y%f.p + f.p) % f.p * w
+		x, y = x/f.p, y/f.p
+	}
+	return z
+}
+
+func (f *field) mul(x, y int) int {
+	a, b := f.digits(x), f.digits(y)
+	prod := make([]int, 2*f.n)
+	for i, ai := range a {
+		for j, bj := range b {
+			prod[i+j] = (prod[i+j] + ai*bj) % f.p
+		}
+	}
+	for k := len(prod) - 1; k >= f.n; k-- {
+		c := prod[k]
+		prod[k] = 0
+		for i, pi := range f.poly {
+			prod[k-f.n+i] = (prod[k-f.n+i] + (f.p-c)*pi) % f.p
+		}
+	}
+	return f.element(prod[:f.n])
+}
+
+// cyclotomy returns the class of every element of f modulo the subgroup of
+// index e of the multiplicative group, with -1 for zero, and a
+// representative of each class. e must divide q-1.
+func (f *field) cyclotomy(e int) (class, reps []int) {
+	g := 1
+	for order := 0; order != f.q-1; {
+		g++
+		order = 1
+		for x := g; x != 1; x = f.mul(x, g) {
+			order++
+		}
+	}
+	if f.q == 2 {
+		g = 1
+	}
+	class = make([]int, f.q)
+	class[0] = -1
+	for i, x := 0, 1; i < f.q-1; i, x = i+1, f.mul(x, g) {
+		class[x] = i % e
+		if i < e {
+			reps = append(reps, x)
+		}
+	}
+	return class, reps
+}
+
+// irreducible reports whether the monic polynomial with coefficients a
+// (lowest first) has no monic factor of smaller positive degree over GF(p).
+func irreducible(a []int, p int) bool {
+	n := len(a) - 1
+	for deg := 1; 2*deg <= n; deg++ {
+		count := 1
+		for range deg {
+			count *= p
+		}
+		for c := range count {
+			b := make([]int, deg+1)
+			for i := range deg {
+				b[i] = c % p
+				c /= p
+			}
+			b[deg] = 1
+			if polyDivides(b, a, p) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// polyDivides reports whether the monic polynomial b divides a over GF(p).
+func polyDivides(b, a []int, p int) bool {
+	r := append([]int(nil), a...)
+	for k := len(r) - 1; k >= len(b)-1; k-- {
+		c := r[k]
+		for i, bi := range b {
+			r[k-len(b)+1+i] = ((r[k-len(b)+1+i]-c*bi)%p + p) % p
+		}
+	}
+	for _, c := range r[:len(b)-1] {
+		if c != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,101 @@
+package damm_test
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/go-oss/damm"
+)
+
+func TestNewField_matrix(t *testing.T) {
+	t.Parallel()
+	for _, p := range []int{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37, 41, 43, 47, 53, 59, 61, 67, 71, 73, 79, 83, 89, 97} {
+		for n, q := 1, p; q <= 128; n, q = n+1, q*p {
+			if q < 3 {
+				continue
+			}
+			t.Run(fmt.Sprintf("GF(%d^%d)", p, n), func(t *testing.T) {
+				t.Parallel()
+				d, err := damm.NewField(p, n)
+				if err != nil {
+					t.Fatalf("NewField(%d, %d) error: %v", p, n, err)
+				}
+				if d.Modulus() != q {
+					t.Errorf("Modulus() = %d; want %d", d.Modulus(), q)
+				}
+				assertWeaklyTotallyAntiSymmetric(t, genMatrix(d), q)
+			})
+		}
+	}
+}
+
+func TestNewField_binary(t *testing.T) {
+	t.Parallel()
+	r := rand.New(rand.NewPCG(1, 2))
+	for _, tt := range []struct {
+		n    int
+		want damm.Damm
+	}{
+		{5, damm.New32()},
+		{6, damm.New64()},
+	} {
+		d, err := damm.NewField(2, tt.n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for range 100 {
+			digits := make([]int, r.IntN(20))
+			for i := range digits {
+				digits[i] = r.IntN(d.Modulus())
+			}
+			if got, want := d.Generate(digits), tt.want.Generate(digits); got != want {
+				t.Fatalf("NewField(2, %d).Generate(%v) = %d; want %d", tt.n, digits, got, want)
+			}
+		}
+	}
+}
+
+func TestNewField_multiplier(t *testing.T) {
+	t.Parallel()
+	for _, pn := range [][2]int{{3, 1}, {5, 1}, {7, 1}, {2, 2}, {2, 3}, {3, 2}, {2, 4}, {5, 2}, {3, 3}} {
+		p, n := pn[0], pn[1]
+		d, _ := damm.NewField(p, n)
+		for a := 2; a < d.Modulus(); a++ {
+			t.Run(fmt.Sprintf("GF(%d^%d)/%d", p, n, a), func(t *testing.T) {
+				t.Parallel()
+				d, err := damm.NewField(p, n, damm.WithMultiplier(a))
+				if err != nil {
+					t.Fatalf("NewField(%d, %d, WithMultiplier(%d)) error: %v", p, n, a, err)
+				}
+				assertWeaklyTotallyAntiSymmetric(t, genMatrix(d), d.Modulus())
+			})
+		}
+		for _, a := range []int{-1, 0, 1, d.Modulus()} {
+			if _, err := damm.NewField(p, n, damm.WithMultiplier(a)); !errors.Is(err, damm.ErrMultiplier) {
+				t.Errorf("NewField(%d, %d, WithMultiplier(%d)) error = %v; want ErrMultiplier", p, n, a, err)
+			}
+		}
+	}
+}
+
+func TestNewField_errors(t *testing.T) {
+	t.Parallel()
+	for _, tt := range [][2]int{{2, 1}, {1, 3}, {4, 2}, {9, 1}, {3, 0}, {2, 17}, {3, 40}} {
+		if _, err := damm.NewField(tt[0], tt[1]); err == nil {
+			t.Errorf("NewField(%d, %d) error = nil; want error", tt[0], tt[1])
+		}
+	}
+}
+
+func BenchmarkField49(b *testing.B) {
+	d49, _ := damm.NewField(7, 2)
+	digits := make([]int, 64)
+	for i := range digits {
+		digits[i] = i % 49
+	}
+	for range b.N {
+		d49.Generate(digits)
+	}
+}
@@ -0,0 +1,120 @@
+package damm
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Formatter writes checked codes in groups, such as ABCD-EFGH-JKMN-P, and
+// parses them back.
+type Formatter struct {
+	Codec *Codec
+
+	// Groups lists the group sizes from the left; the last size repeats.
+	// No grouping is done if Groups is empty. Sizes must be positive.
+	Groups []int
+
+	// Separator is written between groups. It defaults to "-".
+	Separator string
+
+	// CheckPos is the 1-based position of the check symbol in the code.
+	// Zero places it after the payload.
+	CheckPos int
+}
+
+// Format appends the check symbol to payload and groups the result.
+func (f *Formatter) Format(payload string) (string, error) {
+	check, err := f.Codec.Check(payload)
+	if err != nil {
+		return "", err
+	}
+	i, err := f.checkIndex(len(payload) + 1)
+	if err != nil {
+		return "", err
+	}
+	if err := checkGroups(f.Groups); err != nil {
+		return "", err
+	}
+	code := payload[:i] + string(check) + payload[i:]
+	var b strings.Builder
+	for n, j := 0, 0; j < len(code); n++ {
+		if j > 0 {
+			b.WriteString(f.separator())
+		}
+		size := len(code)
+		if len(f.Groups) > 0 {
+			size = f.Groups[min(n, len(f.Groups)-1)]
+		}
+		end := min(j+size, len(code))
+		b.WriteString(code[j:end])
+		j = end
+	}
+	return b.String(), nil
+}
+
+// Parse removes separators and white space from s, verifies the check
+// symbol and returns the payload in canonical form. Offsets in errors refer
+// to the code with separators and white space removed.
+func (f *Formatter) Parse(s string) (string, error) {
+	sep := f.separator()
+	code := strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || strings.ContainsRune(sep, r) {
+			return -1
+		}
+		return r
+	}, s)
+	if code == "" {
+		return "", ErrNoCheck
+	}
+	i, err := f.checkIndex(len(code))
+	if err != nil {
+		return "", err
+	}
+	digits, err := f.Codec.Decode(code[:i] + code[i+1:] + code[i:i+1])
+	if err != nil {
+		var se *SymbolError
+		var ce *CheckError
+		switch {
+		case errors.As(err, &se):
+			if se.Offset == len(code)-1 {
+				se.Offset = i
+			} else if se.Offset >= i {
+				se.Offset++
+			}
+		case errors.As(err, &ce):
+			ce.Offset = i
+		}
+		return "", err
+	}
+	return f.Codec.a.Encode(digits)
+}
+
+func (f *Formatter) separator() string {
+	if f.Separator == "" {
+		return "-"
+	}
+	return f.Separator
+}
+
+// checkIndex returns the index of the check symbol in a code of n symbols.
+func (f *Formatter) checkIndex(n int) (int, error) {
+	if f.CheckPos == 0 {
+		return n - 1, nil
+	}
+	if f.CheckPos < 0 || f.CheckPos > n {
+		return 0, fmt.Errorf("damm: check position %d out of range for %d symbols", f.CheckPos, n)
+	}
+	return f.CheckPos - 1, nil
+}
+
+// checkGroups returns an error if a group size is not positive.
+func checkGroups(groups []int) error {
+	for _, size := range groups {
+		if size <= 0 {
+			return fmt.Errorf("damm: invalid group size %d", size)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package damm_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/go-oss/damm"
+)
+
+func TestFormatter(t *testing.T) {
+	t.Parallel()
+	c := mustCodec(t, damm.New32(), damm.Crockford)
+	check, _ := c.Check("ABCDEFGHJKMN")
+	tests := []struct {
+		f    damm.Formatter
+		want string
+	}{
+		{damm.Formatter{Codec: c, Groups: []int{4}}, "ABCD-EFGH-JKMN-" + string(check)},
+		{damm.Formatter{Codec: c, Groups: []int{3, 4}, Separator: " "}, "ABC DEFG HJKM N" + string(check)},
+		{damm.Formatter{Codec: c, Groups: []int{4}, CheckPos: 1}, string(check) + "ABC-DEFG-HJKM-N"},
+		{damm.Formatter{Codec: c, Groups: []int{4}, CheckPos: 5}, "ABCD-" + string(check) + "EFG-HJKM-N"},
+		{damm.Formatter{Codec: c}, "ABCDEFGHJKMN" + string(check)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got, err := tt.f.Format("ABCDEFGHJKMN")
+			if err != nil {
+				t.Fatalf("Format error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Format = %q; want %q", got, tt.want)
+			}
+			for _, s := range []string{got, strings.ToLower(got), " " + strings.ReplaceAll(got, "-", " - ") + "\n"} {
+				payload, err := tt.f.Parse(s)
+				if err != nil {
+					t.Fatalf("Parse(%q) error: %v", s, err)
+				}
+				if payload != "ABCDEFGHJKMN" {
+					t.Errorf("Parse(%q) = %q; want %q", s, payload, "ABCDEFGHJKMN")
+				}
+			}
+		})
+	}
+}
+
+func TestFormatter_Parse_errors(t *testing.T) {
+	t.Parallel()
+	c := mustCodec(t, damm.New32(), damm.Crockford)
+	f := damm.Formatter{Codec: c, Groups: []int{4}, CheckPos: 5}
+	code, _ := f.Format("ABCDEFGH")
+	bad := strings.Replace(code, "A", "B", 1)
+	var ce *damm.CheckError
+	if _, err := f.Parse(bad); !errors.As(err, &ce) || ce.Offset != 4 {
+		t.Errorf("Parse(%q) error = %v; want *CheckError at 4", bad, err)
+	}
+	var se *damm.SymbolError
+	if _, err := f.Parse("ABCD-UEFG-H"); !errors.As(err, &se) || se.Offset != 4 {
+		t.Errorf("Parse error = %v; want *SymbolError at 4", err)
+	}
+	if _, err := f.Parse("AB"); err == nil {
+		t.Error("Parse(\"AB\") error = nil; want error")
+	}
+	if _, err := f.Parse(" - "); !errors.Is(err, damm.ErrNoCheck) {
+		t.Errorf("Parse(\" - \") error = %v; want ErrNoCheck", err)
+	}
+}
+
+func TestFormatter_Format_groups(t *testing.T) {
+	t.Parallel()
+	c := mustCodec(t, damm.New32(), damm.Crockford)
+	for _, groups := range [][]int{{0}, {-1}, {4, 0}, {4, -2, 4}} {
+		f := damm.Formatter{Codec: c, Groups: groups}
+		if got, err := f.Format("ABCD"); err == nil {
+			t.Errorf("Format with Groups %v = %q; want error", groups, got)
+		}
+	}
+}
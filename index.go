@@ -0,0 +1,262 @@
+package damm
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Edit is a kind of typing error, as made by the person who typed a code.
+type Edit int
+
+const (
+	Substitution  Edit = iota // one symbol typed in place of another
+	Transposition             // two adjacent symbols swapped
+	Insertion                 // one extra symbol typed
+	Deletion                  // one symbol left out
+)
+
+func (e Edit) String() string {
+	switch e {
+	case Substitution:
+		return "substitution"
+	case Transposition:
+		return "transposition"
+	case Insertion:
+		return "insertion"
+	case Deletion:
+		return "deletion"
+	}
+	return "unknown edit"
+}
+
+// Suggestion is a valid code that an input may have been meant to be.
+type Suggestion struct {
+	Code   string  // the valid code, in canonical form
+	Edit   Edit    // the error that turns Code into the input
+	Offset int     // offset in the input of the first symbol of the error
+	Score  float64 // probability of Code among the suggestions for the input
+}
+
+// Index holds a set of valid codes and finds those that an invalid input
+// is one error away from.
+//
+// Lookup does not compare the input with every stored code. A Damm check
+// digit leaves for each position exactly one symbol that makes the input
+// valid when substituted there, and exactly one when inserted there, and
+// those are found from the interim digits of the prefixes of the input and
+// from the interim digits the suffixes must start from, which are computed
+// once. With the transpositions and deletions that pass verification, this
+// gives at most 4n+1 candidates for an input of n symbols, which are
+// looked up in a map.
+type Index struct {
+	c     *Codec
+	s     stepper
+	codes map[string]struct{}
+}
+
+// NewIndex returns an empty index for codes under c, whose Damm must be
+// made by this package.
+func NewIndex(c *Codec) (*Index, error) {
+	s, ok := c.d.(stepper)
+	if !ok {
+		return nil, ErrDigest
+	}
+	return &Index{c: c, s: s, codes: make(map[string]struct{})}, nil
+}
+
+// Add verifies code and adds it to the index in canonical form.
+func (x *Index) Add(code string) error {
+	digits, err := x.c.decode(code)
+	if err != nil {
+		return err
+	}
+	canonical, _ := x.c.a.Encode(digits)
+	x.codes[canonical] = struct{}{}
+	return nil
+}
+
+func (x *Index) Len() int {
+	return len(x.codes)
+}
+
+// Contains reports whether the canonical form of code is in the index.
+func (x *Index) Contains(code string) bool {
+	digits, err := x.c.a.Decode(code)
+	if err != nil {
+		return false
+	}
+	canonical, _ := x.c.a.Encode(digits)
+	_, ok := x.codes[canonical]
+	return ok
+}
+
+// Lookup returns the stored codes that input is one substitution, adjacent
+// transposition, insertion or deletion away from, most likely first. Input
+// may contain characters outside the alphabet, which only the error at
+// their position can explain. Scores are the probabilities of the codes
+// among those returned, from rough frequencies of the kinds of error; see
+// Suggest for finer models.
+func (x *Index) Lookup(input string) []Suggestion {
+	return x.Suggest(input, nil, 0)
+}
+
+// Suggest is Lookup with scores from model, or the frequencies of the kinds
+// of error if model is nil, returning at most k suggestions if k > 0.
+func (x *Index) Suggest(input string, model ErrorModel, k int) []Suggestion {
+	var stored []Suggestion
+	for _, c := range candidates(x.c, x.s, input) {
+		if _, ok := x.codes[c.Code]; ok {
+			stored = append(stored, c)
+		}
+	}
+	return rank(stored, input, model, k)
+}
+
+// Suggest returns the k most likely valid codes under c that input is one
+// substitution, adjacent transposition, insertion or deletion away from,
+// scored by model as for Index.Suggest, or all of them if k <= 0. It
+// returns ErrDigest if the Damm of c was not made by this package.
+func Suggest(c *Codec, input string, model ErrorModel, k int) ([]Suggestion, error) {
+	s, ok := c.d.(stepper)
+	if !ok {
+		return nil, ErrDigest
+	}
+	return rank(candidates(c, s, input), input, model, k), nil
+}
+
+// rank scores suggestions with model, normalizes the scores to sum to 1 and
+// returns the best k, or all if k <= 0.
+func rank(suggestions []Suggestion, input string, model ErrorModel, k int) []Suggestion {
+	if model == nil {
+		model = editModel{}
+	}
+	for i := range suggestions {
+		suggestions[i].Score = model.Score(suggestions[i], input)
+	}
+	suggestions = sortSuggestions(suggestions)
+	total := 0.0
+	for _, s := range suggestions {
+		total += s.Score
+	}
+	if total > 0 {
+		for i := range suggestions {
+			suggestions[i].Score /= total
+		}
+	}
+	if k > 0 && len(suggestions) > k {
+		suggestions = suggestions[:k]
+	}
+	return suggestions
+}
+
+// sortSuggestions sorts suggestions by descending score and removes all but
+// the first of each code.
+func sortSuggestions(suggestions []Suggestion) []Suggestion {
+	slices.SortStableFunc(suggestions, func(a, b Suggestion) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Code, b.Code)
+	})
+	// The same code can be reached by more than one error, such as a
+	// transposition of equal neighbors; keep the most likely.
+	seen := make(map[string]bool)
+	n := 0
+	for _, s := range suggestions {
+		if !seen[s.Code] {
+			seen[s.Code] = true
+			suggestions[n] = s
+			n++
+		}
+	}
+	return suggestions[:n]
+}
+
+// decodeAny returns the values of the characters of s, with -1 for
+// characters outside the alphabet, and the index of the first and last of
+// those, or len(s) and -1 if there are none.
+func decodeAny(a *Alphabet, s string) (digits []int, first, last int) {
+	digits = make([]int, len(s))
+	first, last = len(s), -1
+	for i := range len(s) {
+		v, ok := a.Value(s[i])
+		if !ok {
+			v = -1
+			first, last = min(first, i), i
+		}
+		digits[i] = v
+	}
+	return digits, first, last
+}
+
+// candidates returns the valid codes under c, whose Damm is s, one error
+// away from input, with Edit and Offset set.
+func candidates(c *Codec, s stepper, input string) []Suggestion {
+	digits, first, last := decodeAny(c.a, input)
+	n := len(digits)
+	// prefix[i] is the interim digit after digits[:i], known for i <= first.
+	prefix := make([]int, first+1)
+	prefix[0] = c.d.Generate(nil)
+	for i := range first {
+		prefix[i+1] = s.step(prefix[i], digits[i])
+	}
+	// target[i] is the interim digit from which digits[i:] ends at 0,
+	// known for i > last.
+	unstep := unstepper(s)
+	target := make([]int, n+1)
+	for i := n - 1; i > last; i-- {
+		target[i] = unstep(target[i+1], digits[i])
+	}
+	known := func(i, j int) bool {
+		// The interim digit before i and the target at j are both known.
+		return i <= first && j > last
+	}
+
+	var found []Suggestion
+	add := func(edit Edit, offset int, code []int) {
+		if code, err := c.a.Encode(code); err == nil {
+			found = append(found, Suggestion{Code: code, Edit: edit, Offset: offset})
+		}
+	}
+	splice := func(i, j int, mid ...int) []int {
+		code := make([]int, 0, n+1)
+		code = append(code, digits[:i]...)
+		code = append(code, mid...)
+		return append(code, digits[j:]...)
+	}
+	for i := range n {
+		if known(i, i+1) {
+			if v := solve(s, prefix[i], target[i+1]); v != digits[i] {
+				add(Substitution, i, splice(i, i+1, v))
+			}
+			// The input has an extra symbol at i, unless it is the only
+			// one, as a code has at least its check symbol.
+			if prefix[i] == target[i+1] && n > 1 {
+				add(Insertion, i, splice(i, i+1))
+			}
+		}
+		if i+1 < n && known(i, i+2) && digits[i] != digits[i+1] && digits[i] >= 0 && digits[i+1] >= 0 {
+			if s.step(s.step(prefix[i], digits[i+1]), digits[i]) == target[i+2] {
+				add(Transposition, i, splice(i, i+2, digits[i+1], digits[i]))
+			}
+		}
+	}
+	// The input lacks a symbol before i.
+	for i := range n + 1 {
+		if known(i, i) {
+			add(Deletion, i, splice(i, i, solve(s, prefix[i], target[i])))
+		}
+	}
+	return found
+}
+
+// solve returns the digit v with interim∘v = z, which is unique as the
+// rows of a quasigroup are permutations.
+func solve(s stepper, interim, z int) int {
+	for v := range s.Modulus() {
+		if s.step(interim, v) == z {
+			return v
+		}
+	}
+	panic("damm: not a quasigroup")
+}
@@ -0,0 +1,144 @@
+package damm_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/go-oss/damm"
+)
+
+// oneEditAway reports whether input is one substitution, adjacent
+// transposition, insertion or deletion away from code.
+func oneEditAway(code, input string) bool {
+	switch len(input) - len(code) {
+	case 0:
+		var diff []int
+		for i := range len(code) {
+			if code[i] != input[i] {
+				diff = append(diff, i)
+			}
+		}
+		return len(diff) == 1 ||
+			len(diff) == 2 && diff[1] == diff[0]+1 && code[diff[0]] == input[diff[1]] && code[diff[1]] == input[diff[0]]
+	case 1:
+		code, input = input, code
+		fallthrough
+	case -1:
+		for i := range len(code) {
+			if code[:i]+code[i+1:] == input {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func newTestIndex(t *testing.T, c *damm.Codec, r *rand.Rand, n int) (*damm.Index, []string) {
+	t.Helper()
+	x, err := damm.NewIndex(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	codes := make([]string, n)
+	for i := range codes {
+		payload := make([]byte, 4+r.IntN(4))
+		for j := range payload {
+			// A small part of the alphabet, so that codes are close.
+			payload[j] = c.Alphabet().Symbol(r.IntN(4))
+		}
+		code, err := c.Append(string(payload))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := x.Add(code); err != nil {
+			t.Fatal(err)
+		}
+		codes[i] = code
+	}
+	return x, codes
+}
+
+func TestIndex_Lookup(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		name string
+		d    damm.Damm
+		a    *damm.Alphabet
+	}{
+		{"New32", damm.New32(), damm.Crockford},
+		{"New36", damm.New36(), damm.Base36},
+		{"New64 domain", damm.New64(damm.WithDomain("user")), damm.Base64URL},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := rand.New(rand.NewPCG(1, 2))
+			c := mustCodec(t, tt.d, tt.a)
+			x, codes := newTestIndex(t, c, r, 2000)
+			for range 300 {
+				code := []byte(codes[r.IntN(len(codes))])
+				i := r.IntN(len(code))
+				var input string
+				switch r.IntN(5) {
+				case 0:
+					code[i] = tt.a.Symbol(r.IntN(tt.a.Len()))
+					input = string(code)
+				case 1:
+					j := min(i+1, len(code)-1)
+					code[i], code[j] = code[j], code[i]
+					input = string(code)
+				case 2:
+					input = string(code[:i]) + string(tt.a.Symbol(r.IntN(4))) + string(code[i:])
+				case 3:
+					input = string(code[:i]) + string(code[i+1:])
+				case 4:
+					code[i] = '!'
+					input = string(code)
+				}
+				var got []string
+				for _, s := range x.Lookup(input) {
+					got = append(got, s.Code)
+				}
+				slices.Sort(got)
+				var want []string
+				for _, stored := range codes {
+					if oneEditAway(stored, input) && !slices.Contains(want, stored) {
+						want = append(want, stored)
+					}
+				}
+				slices.Sort(want)
+				if !slices.Equal(got, want) {
+					t.Fatalf("Lookup(%q) = %q; want %q", input, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestIndex_Lookup_ranking(t *testing.T) {
+	t.Parallel()
+	c := mustCodec(t, damm.New32(), damm.Crockford)
+	x, err := damm.NewIndex(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, _ := c.Append("7K3QZ0")
+	if err := x.Add(code); err != nil {
+		t.Fatal(err)
+	}
+	if !x.Contains(code) || x.Len() != 1 {
+		t.Fatalf("Contains(%q) = false or Len() = %d after Add", code, x.Len())
+	}
+	input := "7K3ZQ0" + code[6:]
+	got := x.Lookup(input)
+	if len(got) == 0 {
+		t.Fatalf("Lookup(%q) = []; want %q", input, code)
+	}
+	want := []damm.Suggestion{{Code: code, Edit: damm.Transposition, Offset: 3, Score: got[0].Score}}
+	if !slices.Equal(got, want) {
+		t.Errorf("Lookup(%q) = %+v; want %+v", input, got, want)
+	}
+	if err := x.Add(input); err == nil {
+		t.Errorf("Add(%q) error = nil", input)
+	}
+}
@@ -0,0 +1,82 @@
+package damm_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-oss/damm"
+)
+
+func alnum(s string) []int {
+	const symbols = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ*"
+	digits := make([]int, len(s))
+	for i := range len(s) {
+		for v := range len(symbols) {
+			if symbols[v] == s[i] {
+				digits[i] = v
+			}
+		}
+	}
+	return digits
+}
+
+func TestISO7064(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		c       damm.Checker
+		payload []int
+		want    int
+		checks  []int
+		radix   int
+	}{
+		{"MOD 11-2", damm.NewMod11_2(), decimal("0794"), 0, []int{0}, 10},
+		{"MOD 11-2/ISNI", damm.NewMod11_2(), decimal("000000021825009"), 7, []int{7}, 10},
+		{"MOD 11-2/X", damm.NewMod11_2(), decimal("000000021694233"), 10, []int{10}, 10},
+		{"MOD 37-2", damm.NewMod37_2(), alnum("G123498654321"), 17, alnum("H"), 36},
+		{"MOD 97-10", damm.NewMod97_10(), decimal("794"), 44, decimal("44"), 10},
+		{"MOD 97-10/IBAN", damm.NewMod97_10(), decimal("32142829123456987654321611"), 82, decimal("82"), 10},
+		{"MOD 37,36", damm.NewMod37_36(), alnum("A12425GABC1234002"), 22, alnum("M"), 36},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.c.Generate(tt.payload); got != tt.want {
+				t.Errorf("Generate(%v) = %d; want %d", tt.payload, got, tt.want)
+			}
+			code := append(tt.payload, tt.checks...)
+			if !tt.c.Verify(code) {
+				t.Errorf("Verify(%v) = false; want true", code)
+			}
+			for i := range tt.payload {
+				for v := range tt.radix {
+					if v == tt.payload[i] {
+						continue
+					}
+					bad := append([]int(nil), code...)
+					bad[i] = v
+					if tt.c.Verify(bad) {
+						t.Fatalf("Verify(%v) = true; want false", bad)
+					}
+				}
+			}
+			if len(tt.checks) == 1 {
+				assertTranspositions(t, tt.c, tt.payload)
+			}
+		})
+	}
+}
+
+func TestMod97_10_range(t *testing.T) {
+	t.Parallel()
+	mod97 := damm.NewMod97_10()
+	for n := range 10000 {
+		payload := decimal(fmt.Sprintf("%04d", n))
+		check := mod97.Generate(payload)
+		if check < 2 || check > 98 {
+			t.Fatalf("Generate(%v) = %d; want 2..98", payload, check)
+		}
+		if code := append(payload, check/10, check%10); !mod97.Verify(code) {
+			t.Fatalf("Verify(%v) = false; want true", code)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package damm
+
+type luhn struct{}
+
+// NewLuhn returns the Luhn (mod 10) algorithm over decimal digits.
+func NewLuhn() Checker {
+	return luhn{}
+}
+
+func luhnSum(digits []int, double int) (sum int) {
+	for i := range digits {
+		digit := digits[len(digits)-1-i]
+		if i%2 == double {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+	}
+	return sum
+}
+
+func (luhn) Generate(digits []int) int {
+	return (10 - luhnSum(digits, 0)%10) % 10
+}
+
+func (luhn) Verify(digits []int) bool {
+	return luhnSum(digits, 1)%10 == 0
+}
@@ -0,0 +1,63 @@
+package damm_test
+
+import (
+	"testing"
+
+	"github.com/go-oss/damm"
+)
+
+func TestLuhn(t *testing.T) {
+	t.Parallel()
+	luhn := damm.NewLuhn()
+	tests := []struct {
+		payload string
+		want    int
+	}{
+		{"7992739871", 3},
+		{"453201511283036", 6},
+		{"0", 0},
+		{"", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.payload, func(t *testing.T) {
+			digits := decimal(tt.payload)
+			if got := luhn.Generate(digits); got != tt.want {
+				t.Errorf("Generate(%v) = %d; want %d", digits, got, tt.want)
+			}
+			if !luhn.Verify(append(digits, tt.want)) {
+				t.Errorf("Verify(%v) = false; want true", append(digits, tt.want))
+			}
+		})
+	}
+	assertSingleErrors(t, luhn, decimal("7992739871"), 10)
+}
+
+func decimal(s string) []int {
+	digits := make([]int, len(s))
+	for i := range len(s) {
+		digits[i] = int(s[i] - '0')
+	}
+	return digits
+}
+
+// assertSingleErrors checks that every single substitution in the checked
+// code of payload is detected.
+func assertSingleErrors(t *testing.T, c damm.Checker, payload []int, radix int) {
+	t.Helper()
+	code := append(payload[:len(payload):len(payload)], c.Generate(payload))
+	if !c.Verify(code) {
+		t.Fatalf("Verify(%v) = false; want true", code)
+	}
+	for i := range payload {
+		for v := range radix {
+			if v == payload[i] {
+				continue
+			}
+			bad := append([]int(nil), code...)
+			bad[i] = v
+			if c.Verify(bad) {
+				t.Fatalf("Verify(%v) = true; want false", bad)
+			}
+		}
+	}
+}
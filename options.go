@@ -0,0 +1,70 @@
+package damm
+
+// An Option configures a Damm.
+type Option func(*options)
+
+type options struct {
+	multiplier    *int
+	constantTime  bool
+	lengthBinding bool
+	domain        *string
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithMultiplier sets a in x∘y = a·(x-y) for Damm over fields. a is a field
+// element written as a number, as the symbols are (see NewField), and must
+// not be 0 or 1. Every such a gives a weakly totally anti-symmetric
+// quasigroup, so a can be chosen to match a partner system.
+func WithMultiplier(a int) Option {
+	return func(o *options) {
+		o.multiplier = &a
+	}
+}
+
+// WithConstantTime makes Generate and Verify take time that depends only on
+// the number of digits, for check symbols in secrets such as API keys. It is
+// supported over GF(2^n) only. See also Codec.VerifyConstantTime.
+func WithConstantTime() Option {
+	return func(o *options) {
+		o.constantTime = true
+	}
+}
+
+// WithLengthBinding starts the interim digit at a non-zero seed instead of
+// 0, so that inserting or deleting leading zeros changes the check digit
+// unless their number is a multiple of the length of the cycle of x ↦ x∘0
+// through the seed: 31 for New32, 63 for New64, the order of a for other
+// fields (4 for NewField(7, 2)), 6 for New36 and 16 for New62. Check digits
+// differ from those without the option, and Generate and Verify are slower.
+func WithLengthBinding() Option {
+	return func(o *options) {
+		o.lengthBinding = true
+	}
+}
+
+// WithDomain separates the check digits of a domain, such as a kind of ID,
+// from those of other domains and of the default domain without the
+// option, by starting the interim digit at a seed derived from the domain
+// name. A code is only valid in a domain with the same seed: as x ↦ x∘y is
+// a permutation for every y, different seeds give different check digits
+// for every payload. The detection of errors within a code is unchanged.
+//
+// Seeds are taken from a SHA-256 of domain, so two domain names share one
+// with probability 1/(q-1), where q is the modulus. With WithLengthBinding
+// the seed stays on the cycle that option chose, which keeps its guarantee
+// but raises the probability to 1/(c-1) for a cycle of length c. If that
+// leaves a single seed, as for a cycle of length 2, NewField and NewTable
+// return an error. As Generate(nil) returns the seed, the domains of an
+// application can be checked for collisions once at startup.
+func WithDomain(domain string) Option {
+	return func(o *options) {
+		o.domain = &domain
+	}
+}
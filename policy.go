@@ -0,0 +1,100 @@
+package damm
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrEmpty is returned by a Policy with RejectEmpty for empty codes.
+	ErrEmpty = errors.New("damm: code is empty")
+
+	// ErrTooShort is returned by a Policy for codes shorter than MinLen.
+	ErrTooShort = errors.New("damm: code is too short")
+
+	// ErrTooLong is returned by a Policy for codes longer than MaxLen.
+	ErrTooLong = errors.New("damm: code is too long")
+
+	// ErrLength is returned by a Policy for codes whose length is not Len.
+	ErrLength = errors.New("damm: code has the wrong length")
+
+	// ErrAllZero is returned by a Policy with RejectZero for codes whose
+	// payload is all zeros.
+	ErrAllZero = errors.New("damm: payload is all zeros")
+)
+
+// Policy adds rules on the shape of codes to a Checker. Without rules,
+// Verify accepts the empty code and, for Damm, every code of zeros, as the
+// interim digit never leaves 0. Lengths count the check digit. Errors for
+// broken rules wrap the sentinel of the rule, so that errors.Is tells them
+// apart, and are reported before any check digit mismatch.
+type Policy struct {
+	Checker Checker
+
+	// MinLen and MaxLen bound the length of codes. Zero means no bound.
+	MinLen, MaxLen int
+
+	// Len is the length of every code. Zero means any length.
+	Len int
+
+	// RejectEmpty rejects the empty code, which has no check digit.
+	RejectEmpty bool
+
+	// RejectZero rejects codes whose payload is all zeros, including the
+	// code of a lone check digit.
+	RejectZero bool
+}
+
+// Generate returns the check digit for payload, or an error if the code it
+// would make breaks a rule.
+func (p *Policy) Generate(payload []int) (int, error) {
+	if err := p.checkLen(len(payload) + 1); err != nil {
+		return 0, err
+	}
+	if p.RejectZero && allZero(payload) {
+		return 0, ErrAllZero
+	}
+	return p.Checker.Generate(payload), nil
+}
+
+// Verify returns nil if code follows the rules and ends with a valid check
+// digit, and a *CheckError if only the check digit is wrong.
+func (p *Policy) Verify(code []int) error {
+	if len(code) == 0 && p.RejectEmpty {
+		return ErrEmpty
+	}
+	if err := p.checkLen(len(code)); err != nil {
+		return err
+	}
+	if len(code) > 0 && p.RejectZero && allZero(code[:len(code)-1]) {
+		return ErrAllZero
+	}
+	switch {
+	case p.Checker.Verify(code):
+		return nil
+	case len(code) == 0:
+		return ErrNoCheck
+	}
+	return &CheckError{Offset: len(code) - 1}
+}
+
+func (p *Policy) checkLen(n int) error {
+	switch {
+	case p.Len > 0 && n != p.Len:
+		return fmt.Errorf("%w: %d symbols, want %d", ErrLength, n, p.Len)
+	case n < p.MinLen:
+		return fmt.Errorf("%w: %d symbols, want at least %d", ErrTooShort, n, p.MinLen)
+	case p.MaxLen > 0 && n > p.MaxLen:
+		return fmt.Errorf("%w: %d symbols, want at most %d", ErrTooLong, n, p.MaxLen)
+	}
+	return nil
+}
+
+func allZero(digits []int) bool {
+	for _, digit := range digits {
+		if digit != 0 {
+			return false
+		}
+	}
+	return true
+}
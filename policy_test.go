@@ -0,0 +1,71 @@
+package damm_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-oss/damm"
+)
+
+func TestPolicy_Verify(t *testing.T) {
+	t.Parallel()
+	d := damm.New32()
+	valid := append([]int{7, 3, 31}, d.Generate([]int{7, 3, 31}))
+	invalid := []int{7, 3, 31, valid[3] ^ 1}
+	for _, tt := range []struct {
+		name   string
+		policy damm.Policy
+		code   []int
+		want   error
+	}{
+		{"no rules empty", damm.Policy{}, nil, nil},
+		{"no rules zeros", damm.Policy{}, []int{0, 0, 0, 0}, nil},
+		{"no rules valid", damm.Policy{}, valid, nil},
+		{"empty", damm.Policy{RejectEmpty: true}, nil, damm.ErrEmpty},
+		{"empty before min", damm.Policy{RejectEmpty: true, MinLen: 2}, []int{}, damm.ErrEmpty},
+		{"min", damm.Policy{MinLen: 5}, valid, damm.ErrTooShort},
+		{"min ok", damm.Policy{MinLen: 4}, valid, nil},
+		{"max", damm.Policy{MaxLen: 3}, valid, damm.ErrTooLong},
+		{"max ok", damm.Policy{MaxLen: 4}, valid, nil},
+		{"len short", damm.Policy{Len: 5}, valid, damm.ErrLength},
+		{"len long", damm.Policy{Len: 3}, valid, damm.ErrLength},
+		{"len ok", damm.Policy{Len: 4}, valid, nil},
+		{"zero", damm.Policy{RejectZero: true}, []int{0, 0, 0, 0}, damm.ErrAllZero},
+		{"zero check only", damm.Policy{RejectZero: true}, []int{0}, damm.ErrAllZero},
+		{"zero ok", damm.Policy{RejectZero: true}, valid, nil},
+		{"rules before check", damm.Policy{MinLen: 5}, invalid, damm.ErrTooShort},
+	} {
+		tt.policy.Checker = d
+		if err := tt.policy.Verify(tt.code); !errors.Is(err, tt.want) {
+			t.Errorf("%s: Verify(%v) error = %v; want %v", tt.name, tt.code, err, tt.want)
+		}
+	}
+}
+
+func TestPolicy_Verify_check(t *testing.T) {
+	t.Parallel()
+	p := damm.Policy{Checker: damm.New32(), RejectEmpty: true, RejectZero: true}
+	var ce *damm.CheckError
+	if err := p.Verify([]int{7, 3, 31, 0}); !errors.As(err, &ce) || ce.Offset != 3 {
+		t.Errorf("Verify error = %v; want *CheckError at offset 3", err)
+	}
+	p = damm.Policy{Checker: damm.New32(damm.WithDomain("user"))}
+	if err := p.Verify(nil); !errors.Is(err, damm.ErrNoCheck) {
+		t.Errorf("Verify(nil) error = %v; want %v", err, damm.ErrNoCheck)
+	}
+}
+
+func TestPolicy_Generate(t *testing.T) {
+	t.Parallel()
+	d := damm.New32()
+	p := damm.Policy{Checker: d, Len: 4, RejectZero: true}
+	if check, err := p.Generate([]int{7, 3, 31}); err != nil || check != d.Generate([]int{7, 3, 31}) {
+		t.Errorf("Generate = %d, %v; want %d, nil", check, err, d.Generate([]int{7, 3, 31}))
+	}
+	if _, err := p.Generate([]int{7, 3}); !errors.Is(err, damm.ErrLength) {
+		t.Errorf("Generate(short) error = %v; want %v", err, damm.ErrLength)
+	}
+	if _, err := p.Generate([]int{0, 0, 0}); !errors.Is(err, damm.ErrAllZero) {
+		t.Errorf("Generate(zeros) error = %v; want %v", err, damm.ErrAllZero)
+	}
+}
@@ -0,0 +1,438 @@
+package damm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+)
+
+var (
+	// ErrOrder is returned for orders that have no totally anti-symmetric
+	// quasigroup. Such quasigroups exist for every order except 2 and 6.
+	ErrOrder = errors.New("damm: no totally anti-symmetric quasigroup of this order")
+
+	// ErrTable is returned for tables that are not weakly totally
+	// anti-symmetric quasigroups.
+	ErrTable = errors.New("damm: invalid quasigroup table")
+)
+
+// Quasigroup returns the table of a weakly totally anti-symmetric quasigroup
+// of the given order, such that table[x][x] = 0 and
+// table[table[c][x]][y] = table[table[c][y]][x] only if x = y.
+//
+// Odd orders use x∘y = 2(x-y) over the integers modulo order. Orders
+// divisible by 4 use the direct product of x∘y = 2(x+y) over GF(2)[X]
+// modulo X^k+X+1 and an odd order. Orders 2 modulo 4 are prolongations of
+// quasigroups x∘y = x + φ(y-x) of order-1 with an orthomorphism φ. Where no
+// prolongation of the linear, cyclotomic and product φ that are tried works,
+// the table is found by a randomized search. The search stops when ctx is
+// done. The result is the
+// same for every call with the same order.
+func Quasigroup(ctx context.Context, order int) ([][]int, error) {
+	switch {
+	case order < 1 || order == 2 || order == 6:
+		return nil, ErrOrder
+	case order%2 == 1:
+		return cyclicQuasigroup(order), nil
+	case order%4 == 0:
+		k := 0
+		for order%2 == 0 {
+			order /= 2
+			k++
+		}
+		return productQuasigroup(binaryQuasigroup(k), cyclicQuasigroup(order)), nil
+	default:
+		b, err := searchBordered(ctx, order-1)
+		if err != nil {
+			return nil, err
+		}
+		return weaken(b.table), nil
+	}
+}
+
+// CheckQuasigroup returns an error wrapping ErrTable unless table is a
+// weakly totally anti-symmetric quasigroup.
+func CheckQuasigroup(table [][]int) error {
+	n := len(table)
+	if n == 0 {
+		return fmt.Errorf("%w: empty", ErrTable)
+	}
+	cols := make([][]bool, n)
+	for x := range n {
+		cols[x] = make([]bool, n)
+	}
+	for x := range n {
+		if len(table[x]) != n {
+			return fmt.Errorf("%w: row %d has %d entries; want %d", ErrTable, x, len(table[x]), n)
+		}
+		row := make([]bool, n)
+		for y, v := range table[x] {
+			if v < 0 || v >= n {
+				return fmt.Errorf("%w: %d∘%d = %d out of range", ErrTable, x, y, v)
+			}
+			if row[v] || cols[y][v] {
+				return fmt.Errorf("%w: %d∘%d = %d repeats a symbol", ErrTable, x, y, v)
+			}
+			row[v], cols[y][v] = true, true
+		}
+		if table[x][x] != 0 {
+			return fmt.Errorf("%w: %d∘%d = %d; want 0", ErrTable, x, x, table[x][x])
+		}
+	}
+	for c := range n {
+		for x := range n {
+			for y := x + 1; y < n; y++ {
+				if table[table[c][x]][y] == table[table[c][y]][x] {
+					return fmt.Errorf("%w: (%d∘%d)∘%d = (%d∘%d)∘%d", ErrTable, c, x, y, c, y, x)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func makeTable(n int) [][]int {
+	table := make([][]int, n)
+	for x := range table {
+		table[x] = make([]int, n)
+	}
+	return table
+}
+
+// cyclicQuasigroup returns x∘y = 2(x-y) modulo an odd n.
+func cyclicQuasigroup(n int) [][]int {
+	table := makeTable(n)
+	for x := range n {
+		for y := range n {
+			table[x][y] = 2 * (x - y + n) % n
+		}
+	}
+	return table
+}
+
+// binaryQuasigroup returns x∘y = 2(x+y) over GF(2)[X] modulo X^k+X+1, the
+// construction behind New32 and New64 with a polynomial that exists for
+// every k. It needs k >= 2.
+func binaryQuasigroup(k int) [][]int {
+	modulus := 1 << k
+	table := makeTable(modulus)
+	for x := range modulus {
+		for y := range modulus {
+			table[x][y] = calculate([]int{x ^ y}, modulus, modulus|3)
+		}
+	}
+	return table
+}
+
+// productQuasigroup returns the direct product of a and b, which is weakly
+// totally anti-symmetric if both factors are.
+func productQuasigroup(a, b [][]int) [][]int {
+	n := len(b)
+	table := makeTable(len(a) * n)
+	for x := range table {
+		for y := range table {
+			table[x][y] = a[x/n][y/n]*n + b[x%n][y%n]
+		}
+	}
+	return table
+}
+
+// weaken turns a totally anti-symmetric quasigroup into a weak one by
+// permuting its columns so that the diagonal is constant and then renaming
+// that symbol to 0.
+func weaken(table [][]int) [][]int {
+	n := len(table)
+	e := table[0][0]
+	col := make([]int, n)
+	for x := range n {
+		for y := range n {
+			if table[x][y] == e {
+				col[x] = y
+			}
+		}
+	}
+	rename := func(v int) int {
+		switch v {
+		case 0:
+			return e
+		case e:
+			return 0
+		}
+		return v
+	}
+	weak := makeTable(n)
+	for x := range n {
+		for y := range n {
+			weak[x][y] = rename(table[rename(x)][col[rename(y)]])
+		}
+	}
+	return weak
+}
+
+// bordered is a bordered diagonally cyclic Latin square over G ∪ {∞} for an
+// abelian group G on 0..m-1 and ∞ = m, invariant under the translations of G.
+// Its entries are
+//
+//	x∘y = x + g[y-x], x∘∞ = x + g[m], ∞∘y = y + b, ∞∘∞ = ∞,
+//
+// where b is the difference that g[d]-d misses. Translation invariance means
+// only c = 0 and c = ∞ need checking for anti-symmetry.
+type bordered struct {
+	m     int
+	add   [][]int
+	neg   []int
+	g     []int
+	table [][]int
+	seen  []int
+}
+
+func newBordered(m int, add func(x, y int) int) *bordered {
+	b := &bordered{
+		m:     m,
+		add:   makeTable(m),
+		neg:   make([]int, m),
+		g:     make([]int, m+1),
+		table: makeTable(m + 1),
+		seen:  make([]int, m),
+	}
+	for x := range m {
+		for y := range m {
+			b.add[x][y] = add(x, y)
+			if b.add[x][y] == 0 {
+				b.neg[x] = y
+			}
+		}
+	}
+	return b
+}
+
+// cost returns the number of violations of the Latin and the anti-symmetry
+// properties, and fills in table.
+func (b *bordered) cost() int {
+	m := b.m
+	clear(b.seen)
+	violations := 0
+	for _, v := range b.g {
+		if v < m && b.seen[v] > 0 {
+			violations += 2
+		}
+		if v < m {
+			b.seen[v]++
+		}
+	}
+	clear(b.seen)
+	for d := range m {
+		if b.g[d] == m {
+			continue
+		}
+		diff := b.add[b.g[d]][b.neg[d]]
+		if b.seen[diff] > 0 {
+			violations += 2
+		}
+		b.seen[diff]++
+	}
+	border := 0
+	for diff := range m {
+		if b.seen[diff] == 0 {
+			border = diff
+			break
+		}
+	}
+	t := b.table
+	for x := range m {
+		for y := range m {
+			v := b.g[b.add[y][b.neg[x]]]
+			if v != m {
+				v = b.add[x][v]
+			}
+			t[x][y] = v
+		}
+		t[x][m] = b.add[x][b.g[m]]
+		t[m][x] = b.add[x][border]
+	}
+	t[m][m] = m
+	for _, c := range []int{0, m} {
+		for x := range m + 1 {
+			for y := x + 1; y <= m; y++ {
+				if t[t[c][x]][y] == t[t[c][y]][x] {
+					violations++
+				}
+			}
+		}
+	}
+	return violations
+}
+
+// prolong sets g to phi with the transversal y = x + d moved to the border.
+func (b *bordered) prolong(phi []int, d int) {
+	copy(b.g, phi)
+	b.g[b.m] = b.g[d]
+	b.g[d] = b.m
+}
+
+// searchBordered returns a totally anti-symmetric bordered quasigroup of
+// order m+1 for odd m. It first tries prolongations of x∘y = x + φ(y-x) for
+// cyclotomic orthomorphisms φ(d) = λ_i·d over GF(m) if m is a prime power,
+// where i is the class of d modulo the subgroup of index 2 or 4 of the
+// multiplicative group, and otherwise for linear φ over Z_m and then for
+// product orthomorphisms over GF(q) × Z_{m/q}. If none works, it anneals
+// from the best of them.
+func searchBordered(ctx context.Context, m int) (*bordered, error) {
+	var b *bordered
+	best, start := math.MaxInt, make([]int, m+1)
+	phi := make([]int, m)
+	try := func(reps []int) bool {
+		for _, d := range reps {
+			b.prolong(phi, d)
+			c := b.cost()
+			if c == 0 {
+				return true
+			}
+			if c < best {
+				best = c
+				copy(start, b.g)
+			}
+		}
+		return false
+	}
+	r := rand.New(rand.NewPCG(uint64(m), 0))
+	if p, k, ok := primePower(m); ok {
+		f, _ := newField(p, k)
+		b = newBordered(m, f.add)
+		mul := makeTable(m)
+		for x := range m {
+			for y := range m {
+				mul[x][y] = f.mul(x, y)
+			}
+		}
+		// Scaling by an element of the subgroup maps the prolongation along
+		// d to the one along a multiple of d, so one d per class covers all.
+		class, reps := f.cyclotomy(2)
+		for alpha := 1; alpha < m; alpha++ {
+			for beta := 1; beta < m; beta++ {
+				lambda := []int{alpha, beta}
+				for d := range m {
+					phi[d] = mul[lambda[max(class[d], 0)]][d]
+				}
+				if try(reps) {
+					return b, nil
+				}
+			}
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+		}
+		if (m-1)%4 == 0 {
+			class, reps := f.cyclotomy(4)
+			lambda := make([]int, 4)
+			for i := range 1 << 16 {
+				if i%256 == 0 && ctx.Err() != nil {
+					return nil, ctx.Err()
+				}
+				for j := range lambda {
+					lambda[j] = 1 + r.IntN(m-1)
+				}
+				for d := range m {
+					phi[d] = mul[lambda[max(class[d], 0)]][d]
+				}
+				if try(reps) {
+					return b, nil
+				}
+			}
+		}
+	} else {
+		b = newBordered(m, func(x, y int) int { return (x + y) % m })
+		reps := make([]int, m-1)
+		for d := range reps {
+			reps[d] = d + 1
+		}
+		for l := 2; l < m; l++ {
+			if gcd(l, m) != 1 || gcd(l-1, m) != 1 {
+				continue
+			}
+			for d := range m {
+				phi[d] = l * d % m
+			}
+			if try(reps) {
+				return b, nil
+			}
+		}
+
+		// Over GF(q) × Z_n for the power q of the smallest prime of m,
+		// φ(d, e) = (λ_e·d, μe) is an orthomorphism for any λ_e other than
+		// 0 and 1 and any μ with μ and μ-1 prime to n, and random choices
+		// soon give one that prolongs.
+		q := 1
+		for range k {
+			q *= p
+		}
+		n := m / q
+		f, _ := newField(p, k)
+		b = newBordered(m, func(x, y int) int {
+			return f.add(x/n, y/n)*n + (x%n+y%n)%n
+		})
+		best = math.MaxInt
+		lambda := make([]int, n)
+		for i := range 1 << 12 {
+			if i%16 == 0 && ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			for e := range lambda {
+				lambda[e] = 2 + r.IntN(q-2)
+			}
+			mu := 2 + r.IntN(n-2)
+			if gcd(mu, n) != 1 || gcd(mu-1, n) != 1 {
+				continue
+			}
+			for d := range m {
+				phi[d] = f.mul(lambda[d%n], d/n)*n + mu*(d%n)%n
+			}
+			if try(reps) {
+				return b, nil
+			}
+		}
+	}
+
+	for round := 0; ; round++ {
+		if round == 0 {
+			copy(b.g, start)
+		} else {
+			for i, v := range r.Perm(m + 1) {
+				b.g[i] = v
+			}
+			if b.g[m] == m {
+				b.g[m], b.g[0] = b.g[0], b.g[m]
+			}
+		}
+		cost, temp := b.cost(), 1.0
+		for i := 0; i < 1<<21; i++ {
+			if i%4096 == 0 && ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			x, y := r.IntN(m+1), r.IntN(m+1)
+			if x == y || (x == m && b.g[y] == m) || (y == m && b.g[x] == m) {
+				continue
+			}
+			b.g[x], b.g[y] = b.g[y], b.g[x]
+			c := b.cost()
+			if c == 0 {
+				return b, nil
+			}
+			if c <= cost || r.Float64() < math.Exp(float64(cost-c)/temp) {
+				cost = c
+			} else {
+				b.g[x], b.g[y] = b.g[y], b.g[x]
+			}
+			temp = max(temp*0.99999, 0.2)
+		}
+	}
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
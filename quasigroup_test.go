@@ -0,0 +1,92 @@
+package damm_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/go-oss/damm"
+)
+
+func TestQuasigroup(t *testing.T) {
+	t.Parallel()
+	orders := []int{46, 50, 62, 64, 81, 86, 100}
+	for n := 1; n <= 44; n++ {
+		orders = append(orders, n)
+	}
+	for _, n := range orders {
+		if n == 2 || n == 6 {
+			continue
+		}
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			t.Parallel()
+			table, err := damm.Quasigroup(context.Background(), n)
+			if err != nil {
+				t.Fatalf("Quasigroup(%d) error: %v", n, err)
+			}
+			if len(table) != n {
+				t.Fatalf("len(Quasigroup(%d)) = %d", n, len(table))
+			}
+			if err := damm.CheckQuasigroup(table); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestQuasigroup_deterministic(t *testing.T) {
+	t.Parallel()
+	a, _ := damm.Quasigroup(context.Background(), 10)
+	b, _ := damm.Quasigroup(context.Background(), 10)
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("Quasigroup(10) = %v, then %v", a, b)
+	}
+}
+
+func TestQuasigroup_errors(t *testing.T) {
+	t.Parallel()
+	for _, n := range []int{-1, 0, 2, 6} {
+		if _, err := damm.Quasigroup(context.Background(), n); !errors.Is(err, damm.ErrOrder) {
+			t.Errorf("Quasigroup(%d) error = %v; want ErrOrder", n, err)
+		}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := damm.Quasigroup(ctx, 46); !errors.Is(err, context.Canceled) {
+		t.Errorf("Quasigroup(46) error = %v; want context.Canceled", err)
+	}
+}
+
+func TestCheckQuasigroup(t *testing.T) {
+	t.Parallel()
+	minus := make([][]int, 5)
+	for x := range minus {
+		minus[x] = make([]int, 5)
+		for y := range minus[x] {
+			minus[x][y] = (x - y + 5) % 5
+		}
+	}
+	tests := []struct {
+		name  string
+		table [][]int
+	}{
+		{"empty", nil},
+		{"ragged", [][]int{{0, 1, 2}, {2, 0}, {1, 2, 0}}},
+		{"range", [][]int{{0, 3, 1}, {2, 0, 1}, {1, 2, 0}}},
+		{"latin", [][]int{{0, 1, 2}, {1, 0, 2}, {2, 1, 0}}},
+		{"diagonal", [][]int{{1, 2, 0}, {2, 0, 1}, {0, 1, 2}}},
+		{"anti-symmetry", minus},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := damm.CheckQuasigroup(tt.table); !errors.Is(err, damm.ErrTable) {
+				t.Errorf("CheckQuasigroup(%v) error = %v; want ErrTable", tt.table, err)
+			}
+		})
+	}
+	if err := damm.CheckQuasigroup(genMatrix(damm.New64())); err != nil {
+		t.Errorf("CheckQuasigroup(New64) error: %v", err)
+	}
+}
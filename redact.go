@@ -0,0 +1,91 @@
+package damm
+
+import "io"
+
+// Redactor is an io.Writer that masks valid codes in the text written to
+// it before passing the text on, for logs where codes are credentials. It
+// finds codes as a TextScanner does and replaces all but the last few
+// symbols of each with '*', keeping separators. Near misses are passed on
+// unmasked.
+//
+// A word that may be a code is held back until the byte after it is
+// written, so codes split across calls to Write are masked as well, and
+// Close must be called to write the last word.
+type Redactor struct {
+	w    io.Writer
+	s    TextScanner
+	keep int
+	word []byte
+	long bool // the word is too long to be a code and is passed on
+	buf  []byte
+}
+
+// NewRedactor returns a Redactor writing to w that masks the codes found
+// by s, keeping the last keep symbols of each visible.
+func NewRedactor(w io.Writer, s *TextScanner, keep int) *Redactor {
+	r := &Redactor{w: w, s: *s, keep: keep}
+	r.s.NearMisses = nil
+	return r
+}
+
+// Write masks the codes in p and writes the result, apart from a word at
+// the end of p that may continue in the next call. It returns an error if
+// a group size of the TextScanner is not positive.
+func (r *Redactor) Write(p []byte) (int, error) {
+	if err := checkGroups(r.s.Groups); err != nil {
+		return 0, err
+	}
+	r.buf = r.buf[:0]
+	for _, c := range p {
+		switch {
+		case !r.s.isWordByte(c):
+			r.endWord()
+			r.buf = append(r.buf, c)
+		case r.long:
+			r.buf = append(r.buf, c)
+		case len(r.word) == maxWord:
+			r.buf = append(r.buf, r.word...)
+			r.buf = append(r.buf, c)
+			r.word, r.long = r.word[:0], true
+		default:
+			r.word = append(r.word, c)
+		}
+	}
+	if len(r.buf) > 0 {
+		if _, err := r.w.Write(r.buf); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// Close writes the word held back by Write, masked if it is a code. It does
+// not close the underlying writer.
+func (r *Redactor) Close() error {
+	r.buf = r.buf[:0]
+	r.endWord()
+	if len(r.buf) == 0 {
+		return nil
+	}
+	_, err := r.w.Write(r.buf)
+	return err
+}
+
+// endWord appends the word held back to r.buf, masked if it is a code.
+func (r *Redactor) endWord() {
+	if m, ok := r.s.match(r.word, 0); ok && m.Err == nil {
+		keep := r.keep
+		for i := m.Offset + int64(len(m.Text)) - 1; i >= m.Offset; i-- {
+			if r.s.isSeparator(r.word[i]) {
+				continue
+			}
+			if keep > 0 {
+				keep--
+			} else {
+				r.word[i] = '*'
+			}
+		}
+	}
+	r.buf = append(r.buf, r.word...)
+	r.word, r.long = r.word[:0], false
+}
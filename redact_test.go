@@ -0,0 +1,48 @@
+package damm_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-oss/damm"
+)
+
+func TestRedactor(t *testing.T) {
+	t.Parallel()
+	c := mustCodec(t, damm.New32(), damm.Crockford)
+	s := &damm.TextScanner{Codec: c, MinLen: 6, Separators: "-"}
+	text := "auth key=7K3Q-Z0M9-TP4P ok; bad key=7K3QZ0M9TP4Q, user WORLD3\nc0ffeea"
+	want := "auth key=****-****-**4P ok; bad key=7K3QZ0M9TP4Q, user ****D3\n*****ea"
+	for split := range len(text) + 1 {
+		var b strings.Builder
+		r := damm.NewRedactor(&b, s, 2)
+		for _, part := range []string{text[:split], text[split:]} {
+			if n, err := r.Write([]byte(part)); n != len(part) || err != nil {
+				t.Fatalf("Write(%q) = %d, %v", part, n, err)
+			}
+		}
+		if err := r.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if got := b.String(); got != want {
+			t.Fatalf("split at %d: got %q; want %q", split, got, want)
+		}
+	}
+}
+
+func TestRedactor_bytes(t *testing.T) {
+	t.Parallel()
+	c := mustCodec(t, damm.New32(), damm.Crockford)
+	s := &damm.TextScanner{Codec: c, MinLen: 6, Separators: "-"}
+	var b strings.Builder
+	r := damm.NewRedactor(&b, s, 4)
+	text := "[7K3Q-Z0M9-TP4P] " + strings.Repeat("X", 5000) + " WORLD3"
+	for i := range len(text) {
+		r.Write([]byte{text[i]})
+	}
+	r.Close()
+	want := "[****-****-TP4P] " + strings.Repeat("X", 5000) + " **RLD3"
+	if got := b.String(); got != want {
+		t.Errorf("got %q; want %q", got, want)
+	}
+}
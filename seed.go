@@ -0,0 +1,100 @@
+package damm
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+)
+
+var (
+	errFieldOption = errors.New("damm: WithMultiplier and WithConstantTime apply to fields only")
+	errDomainSeeds = errors.New("damm: WithDomain needs more than one seed to choose from")
+)
+
+// seeded is a Damm whose interim digit starts at seed instead of 0. The
+// quasigroup is unchanged, so it detects the same errors within a code, and
+// as x ↦ x∘y is a permutation for every y, two different seeds never give
+// the same final interim digit for the same digits.
+type seeded struct {
+	stepper
+	seed int
+}
+
+func (d *seeded) Generate(digits []int) int {
+	interim, q := d.seed, d.Modulus()
+	for _, digit := range digits {
+		if uint(digit) >= uint(q) {
+			return -1
+		}
+		interim = d.step(interim, digit)
+	}
+	return interim
+}
+
+func (d *seeded) Verify(digits []int) bool {
+	return d.Generate(digits) == 0
+}
+
+func (d *seeded) appendIdentity(b []byte) []byte {
+	b = append(b, 'S')
+	b = binary.AppendUvarint(b, uint64(d.seed))
+	return d.stepper.appendIdentity(b)
+}
+
+// applySeed returns d with the seed the options ask for, if any.
+func (o *options) applySeed(d stepper) (Damm, error) {
+	if !o.lengthBinding && o.domain == nil {
+		return d, nil
+	}
+	seed := 0
+	// A domain moves the seed k steps along the cycle of x ↦ x∘0 if
+	// lengths are bound, or sets it to k otherwise, for k in [1, choices],
+	// so that it never keeps the seed of the default domain.
+	choices := d.Modulus() - 1
+	if o.lengthBinding {
+		var cycle int
+		seed, cycle = lengthSeed(d)
+		choices = cycle - 1
+	}
+	if o.domain != nil {
+		if choices < 2 {
+			return nil, errDomainSeeds
+		}
+		k := 1 + int(domainHash(*o.domain)%uint64(choices))
+		if o.lengthBinding {
+			for range k {
+				seed = d.step(seed, 0)
+			}
+		} else {
+			seed = k
+		}
+	}
+	return &seeded{stepper: d, seed: seed}, nil
+}
+
+// domainHash returns the first 8 bytes of the SHA-256 of domain.
+func domainHash(domain string) uint64 {
+	sum := sha256.Sum256([]byte(domain))
+	return binary.BigEndian.Uint64(sum[:])
+}
+
+// lengthSeed returns the smallest digit on a longest cycle of x ↦ x∘0, and
+// the length of the cycle. Starting there, k leading zeros take the
+// interim digit around the cycle and back only if k is a multiple of its
+// length, so inserting or deleting fewer leading zeros than that always
+// changes the check digit. 0 is not on such a cycle, as 0∘0 = 0.
+func lengthSeed(d stepper) (seed, cycle int) {
+	q := d.Modulus()
+	seen := make([]bool, q)
+	for x := range q {
+		n := 0
+		for y := x; !seen[y]; y = d.step(y, 0) {
+			seen[y] = true
+			n++
+		}
+		if n > cycle {
+			seed, cycle = x, n
+		}
+	}
+	return seed, cycle
+}
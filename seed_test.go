@@ -0,0 +1,196 @@
+package damm_test
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/go-oss/damm"
+)
+
+func TestWithLengthBinding(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		name  string
+		d     damm.Damm
+		cycle int
+	}{
+		{"New32", damm.New32(damm.WithLengthBinding()), 31},
+		{"New64", damm.New64(damm.WithLengthBinding()), 63},
+		{"New32 constant time", damm.New32(damm.WithLengthBinding(), damm.WithConstantTime()), 31},
+		{"New36", damm.New36(damm.WithLengthBinding()), 6},
+		{"New62", damm.New62(damm.WithLengthBinding()), 16},
+		{"GF(7^2)", must(damm.NewField(7, 2, damm.WithLengthBinding())), 4},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := rand.New(rand.NewPCG(1, 2))
+			for range 100 {
+				payload := make([]int, 1+r.IntN(20))
+				for i := range payload {
+					payload[i] = r.IntN(tt.d.Modulus())
+				}
+				check := tt.d.Generate(payload)
+				code := append(slices.Clone(payload), check)
+				for k := 1; k <= tt.cycle; k++ {
+					padded := append(make([]int, k), code...)
+					if got, want := tt.d.Verify(padded), k == tt.cycle; got != want {
+						t.Fatalf("Verify(%v) = %v; want %v", padded, got, want)
+					}
+					if got := tt.d.Generate(padded[:len(padded)-1]); (got == check) != (k == tt.cycle) {
+						t.Fatalf("Generate(%v) = %d, Generate(%v) = %d", padded[:len(padded)-1], got, payload, check)
+					}
+				}
+				assertSingleErrors(t, tt.d, payload, tt.d.Modulus())
+				assertTranspositions(t, tt.d, payload)
+			}
+		})
+	}
+}
+
+func TestWithLengthBinding_empty(t *testing.T) {
+	t.Parallel()
+	d := damm.New32(damm.WithLengthBinding())
+	if got := d.Generate(nil); got == 0 {
+		t.Errorf("Generate(nil) = 0; want non-zero")
+	}
+	if d.Verify([]int{0}) {
+		t.Errorf("Verify([0]) = true; want false")
+	}
+}
+
+func TestNewTable_fieldOptions(t *testing.T) {
+	t.Parallel()
+	table := genMatrix(damm.New32())
+	for _, opt := range []damm.Option{damm.WithMultiplier(3), damm.WithConstantTime()} {
+		if _, err := damm.NewTable(table, opt); err == nil {
+			t.Error("NewTable(table, field option) error = nil")
+		}
+	}
+	d, err := damm.NewTable(table, damm.WithLengthBinding())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := damm.New32(damm.WithLengthBinding())
+	digits := []int{0, 0, 7, 31, 2}
+	if got, want := d.Generate(digits), want.Generate(digits); got != want {
+		t.Errorf("Generate(%v) = %d; want %d as for New32", digits, got, want)
+	}
+}
+
+func TestWithDomain(t *testing.T) {
+	t.Parallel()
+	r := rand.New(rand.NewPCG(1, 2))
+	domains := []string{"user", "order", "coupon"}
+	for _, tt := range []struct {
+		name string
+		new  func(opts ...damm.Option) damm.Damm
+	}{
+		{"New32", damm.New32},
+		{"New64", damm.New64},
+		{"New36", damm.New36},
+		{"New62", damm.New62},
+	} {
+		damms := []damm.Damm{tt.new()}
+		for _, domain := range domains {
+			damms = append(damms, tt.new(damm.WithDomain(domain)))
+		}
+		seeds := make(map[int]int)
+		for i, d := range damms {
+			seed := d.Generate(nil)
+			if j, ok := seeds[seed]; ok {
+				t.Fatalf("%s: damms %d and %d share seed %d", tt.name, j, i, seed)
+			}
+			seeds[seed] = i
+		}
+		for range 100 {
+			payload := make([]int, 1+r.IntN(12))
+			for i := range payload {
+				payload[i] = r.IntN(damms[0].Modulus())
+			}
+			for i, d := range damms {
+				code := append(slices.Clone(payload), d.Generate(payload))
+				for j, e := range damms {
+					if got := e.Verify(code); got != (i == j) {
+						t.Fatalf("%s: damms[%d].Verify(%v from damms[%d]) = %v", tt.name, j, code, i, got)
+					}
+				}
+				assertSingleErrors(t, d, payload, d.Modulus())
+				assertTranspositions(t, d, payload)
+			}
+		}
+	}
+}
+
+func TestWithDomain_lengthBinding(t *testing.T) {
+	t.Parallel()
+	d := damm.New32(damm.WithDomain("user"), damm.WithLengthBinding())
+	if e := damm.New32(damm.WithLengthBinding()); d.Generate(nil) == e.Generate(nil) {
+		t.Fatalf("Generate(nil) = %d for the domain and the default domain", d.Generate(nil))
+	}
+	code := []int{7, 30, 4}
+	code = append(code, d.Generate(code))
+	for k := 1; k < 31; k++ {
+		padded := append(make([]int, k), code...)
+		if d.Verify(padded) {
+			t.Fatalf("Verify(%v) = true; want false", padded)
+		}
+	}
+}
+
+func BenchmarkWithLengthBinding(b *testing.B) {
+	for _, bb := range []struct {
+		name string
+		new  func(...damm.Option) damm.Damm
+	}{
+		{"New32", damm.New32},
+		{"New64", damm.New64},
+		{"New36", damm.New36},
+	} {
+		for _, opts := range [][]damm.Option{nil, {damm.WithLengthBinding()}} {
+			d := bb.new(opts...)
+			digits := testDigits64[:d.Modulus()]
+			b.Run(fmt.Sprintf("%s/binding=%v", bb.name, opts != nil), func(b *testing.B) {
+				for range b.N {
+					d.Generate(digits)
+				}
+			})
+		}
+	}
+}
+
+func TestWithDomain_seeds(t *testing.T) {
+	t.Parallel()
+	// Every non-zero seed is used: with q = 3 both 1 and 2.
+	seeds := make(map[int]bool)
+	for i := range 20 {
+		d, err := damm.NewField(3, 1, damm.WithDomain(fmt.Sprint("domain", i)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		seeds[d.Generate(nil)] = true
+	}
+	if len(seeds) != 2 || seeds[0] {
+		t.Errorf("NewField(3, 1) domains use seeds %v; want 1 and 2", seeds)
+	}
+
+	// x ↦ x∘0 = 2x has cycles of length 2 in GF(3) and, with a = 6 of
+	// order 2, in GF(7), which leave one seed for all domains.
+	for _, tt := range []struct {
+		name string
+		p    int
+		opts []damm.Option
+	}{
+		{"NewField(3, 1)", 3, nil},
+		{"NewField(7, 1) multiplier", 7, []damm.Option{damm.WithMultiplier(6)}},
+	} {
+		opts := append(tt.opts, damm.WithLengthBinding(), damm.WithDomain("user"))
+		if _, err := damm.NewField(tt.p, 1, opts...); err == nil {
+			t.Errorf("%s with length binding and a domain: error = nil", tt.name)
+		}
+		if _, err := damm.NewField(tt.p, 1, append(tt.opts, damm.WithLengthBinding())...); err != nil {
+			t.Errorf("%s with length binding: error = %v", tt.name, err)
+		}
+	}
+}
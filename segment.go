@@ -0,0 +1,183 @@
+package damm
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+)
+
+// ErrNotLinear is returned for Damms that are not field constructions, whose
+// segments have no short summary.
+var ErrNotLinear = errors.New("damm: Damm is not a field construction")
+
+// linear is implemented by the field constructions, where a digit y takes
+// the interim digit s to a·s - a·y, so that a run of k digits takes it to
+// a^k·s + c for some c.
+type linear interface {
+	stepper
+	multiplier() int
+	fieldAdd(x, y int) int
+	fieldMul(x, y int) int
+}
+
+// Segment summarizes the effect of a run of digits on the interim digit of
+// a field construction as the map s ↦ scale·s + shift. Runs can be
+// summarized concurrently and combined with Then, which gives the exact
+// check digit of a payload too long for one goroutine. The zero Segment
+// stands for the empty run.
+type Segment struct {
+	d     Damm
+	lin   linear
+	scale int
+	shift int
+}
+
+// Summarize returns the segment of digits under d, which must be made by
+// NewField, New32 or New64, with any options.
+func Summarize(d Damm, digits []int) (Segment, error) {
+	lin, err := linearOf(d)
+	if err != nil {
+		return Segment{}, err
+	}
+	// lin starts from 0 even if d has a seed.
+	shift := lin.Generate(digits)
+	return Segment{d: d, lin: lin, scale: power(lin, lin.multiplier(), len(digits)), shift: shift}, nil
+}
+
+func linearOf(d Damm) (linear, error) {
+	if s, ok := d.(*seeded); ok {
+		d = s.stepper
+	}
+	lin, ok := d.(linear)
+	if !ok {
+		return nil, ErrNotLinear
+	}
+	return lin, nil
+}
+
+// Then returns the segment of the run of s followed by the run of t. Both
+// must be summarized under the same Damm.
+func (s Segment) Then(t Segment) Segment {
+	switch {
+	case s.d == nil:
+		return t
+	case t.d == nil:
+		return s
+	case s.d != t.d:
+		panic("damm: segments of different Damms")
+	}
+	return Segment{
+		d:     s.d,
+		lin:   s.lin,
+		scale: s.lin.fieldMul(t.scale, s.scale),
+		shift: s.lin.fieldAdd(s.lin.fieldMul(t.scale, s.shift), t.shift),
+	}
+}
+
+// Apply returns the interim digit after the run of s, starting from
+// interim.
+func (s Segment) Apply(interim int) int {
+	if s.d == nil {
+		return interim
+	}
+	return s.lin.fieldAdd(s.lin.fieldMul(s.scale, interim), s.shift)
+}
+
+// Check returns the check digit of a payload made of the run of s alone,
+// the same as Generate over its digits.
+func (s Segment) Check() int {
+	if s.d == nil {
+		panic("damm: check digit of the zero Segment")
+	}
+	return s.Apply(s.d.Generate(nil))
+}
+
+// GenerateParallel returns d.Generate(digits), computed on GOMAXPROCS
+// goroutines by summarizing a chunk of digits on each. d must be a field
+// construction, as for Summarize.
+func GenerateParallel(d Damm, digits []int) (int, error) {
+	if _, err := linearOf(d); err != nil {
+		return 0, err
+	}
+	chunks := min(runtime.GOMAXPROCS(0), (len(digits)+batchChunk-1)/batchChunk)
+	if chunks <= 1 {
+		return d.Generate(digits), nil
+	}
+	segments := make([]Segment, chunks)
+	var wg sync.WaitGroup
+	for i := range segments {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			segments[i], _ = Summarize(d, digits[i*len(digits)/chunks:(i+1)*len(digits)/chunks])
+		}()
+	}
+	wg.Wait()
+	var all Segment
+	for _, s := range segments {
+		all = all.Then(s)
+	}
+	return all.Check(), nil
+}
+
+// power returns a^k in the field of lin.
+func power(lin linear, a, k int) int {
+	z := 1
+	for ; k > 0; k >>= 1 {
+		if k&1 == 1 {
+			z = lin.fieldMul(z, a)
+		}
+		a = lin.fieldMul(a, a)
+	}
+	return z
+}
+
+// binaryMul returns x·y in GF(2^n) = GF(2)[X]/(mask), where modulus = 2^n.
+func binaryMul(x, y, modulus, mask int) (z int) {
+	for ; y > 0; y >>= 1 {
+		if y&1 == 1 {
+			z ^= x
+		}
+		x <<= 1
+		if x >= modulus {
+			x ^= mask
+		}
+	}
+	return z
+}
+
+func (d *damm) multiplier() int {
+	return 2
+}
+
+func (d *damm) fieldAdd(x, y int) int {
+	return x ^ y
+}
+
+func (d *damm) fieldMul(x, y int) int {
+	return binaryMul(x, y, d.modulus, d.mask)
+}
+
+func (d *ctDamm) multiplier() int {
+	return d.a
+}
+
+func (d *ctDamm) fieldAdd(x, y int) int {
+	return x ^ y
+}
+
+func (d *ctDamm) fieldMul(x, y int) int {
+	return binaryMul(x, y, 1<<d.n, d.mask)
+}
+
+func (d *fieldDamm) multiplier() int {
+	return d.mul[1]
+}
+
+func (d *fieldDamm) fieldAdd(x, y int) int {
+	return d.f.add(x, y)
+}
+
+func (d *fieldDamm) fieldMul(x, y int) int {
+	return d.f.mul(x, y)
+}
@@ -0,0 +1,115 @@
+package damm_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/go-oss/damm"
+)
+
+func TestSummarize(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		name string
+		d    damm.Damm
+	}{
+		{"New32", damm.New32()},
+		{"New64", damm.New64()},
+		{"New32 a=3", damm.New32(damm.WithMultiplier(3))},
+		{"New32 constant time", damm.New32(damm.WithConstantTime(), damm.WithMultiplier(7))},
+		{"New64 domain", damm.New64(damm.WithDomain("order"), damm.WithLengthBinding())},
+		{"GF(5)", must(damm.NewField(5, 1))},
+		{"GF(3^3)", must(damm.NewField(3, 3))},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := rand.New(rand.NewPCG(1, 2))
+			for range 200 {
+				digits := make([]int, r.IntN(300))
+				for i := range digits {
+					digits[i] = r.IntN(tt.d.Modulus())
+				}
+				var all damm.Segment
+				for rest := digits; len(rest) > 0; {
+					n := r.IntN(len(rest) + 1)
+					s, err := damm.Summarize(tt.d, rest[:n])
+					if err != nil {
+						t.Fatal(err)
+					}
+					all, rest = all.Then(s), rest[n:]
+				}
+				if len(digits) == 0 {
+					all, _ = damm.Summarize(tt.d, nil)
+				}
+				if got, want := all.Check(), tt.d.Generate(digits); got != want {
+					t.Fatalf("Check() over %d digits = %d; want %d", len(digits), got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestSegment_Apply(t *testing.T) {
+	t.Parallel()
+	d := damm.New32()
+	prefix, suffix := []int{3, 1, 4, 1, 5}, []int{9, 2, 6, 5}
+	s, err := damm.Summarize(d, suffix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := s.Apply(d.Generate(prefix)), d.Generate(append(prefix, suffix...)); got != want {
+		t.Errorf("Apply(Generate(prefix)) = %d; want %d", got, want)
+	}
+	var zero damm.Segment
+	if got := zero.Apply(17); got != 17 {
+		t.Errorf("zero Segment Apply(17) = %d; want 17", got)
+	}
+}
+
+func TestSummarize_table(t *testing.T) {
+	t.Parallel()
+	if _, err := damm.Summarize(damm.New36(), []int{1}); !errors.Is(err, damm.ErrNotLinear) {
+		t.Errorf("Summarize(New36) error = %v; want %v", err, damm.ErrNotLinear)
+	}
+	if _, err := damm.GenerateParallel(damm.New62(), []int{1}); !errors.Is(err, damm.ErrNotLinear) {
+		t.Errorf("GenerateParallel(New62) error = %v; want %v", err, damm.ErrNotLinear)
+	}
+}
+
+func TestGenerateParallel(t *testing.T) {
+	t.Parallel()
+	r := rand.New(rand.NewPCG(1, 2))
+	d := damm.New64()
+	for _, n := range []int{0, 1, 5000, 1 << 20} {
+		digits := make([]int, n)
+		for i := range digits {
+			digits[i] = r.IntN(64)
+		}
+		got, err := damm.GenerateParallel(d, digits)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := d.Generate(digits); got != want {
+			t.Errorf("GenerateParallel(%d digits) = %d; want %d", n, got, want)
+		}
+	}
+}
+
+func BenchmarkGenerate_large(b *testing.B) {
+	d := damm.New64()
+	digits := make([]int, 1<<22)
+	b.ResetTimer()
+	for range b.N {
+		d.Generate(digits)
+	}
+}
+
+func BenchmarkGenerateParallel(b *testing.B) {
+	d := damm.New64()
+	digits := make([]int, 1<<22)
+	b.ResetTimer()
+	for range b.N {
+		damm.GenerateParallel(d, digits)
+	}
+}
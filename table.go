@@ -0,0 +1,53 @@
+package damm
+
+type tableDamm struct {
+	table [][]int
+	inv   [][]int // inv[y][z] = x with table[x][y] = z
+}
+
+// NewTable returns a Damm whose interim digit after digit y is table[x][y],
+// where x is the previous interim digit. The table must pass
+// CheckQuasigroup.
+func NewTable(table [][]int, opts ...Option) (Damm, error) {
+	if err := CheckQuasigroup(table); err != nil {
+		return nil, err
+	}
+	t := makeTable(len(table))
+	for x := range table {
+		copy(t[x], table[x])
+	}
+	return newTable(t, opts)
+}
+
+// newTable returns a Damm over a checked table that it may keep.
+func newTable(table [][]int, opts []Option) (Damm, error) {
+	o := newOptions(opts)
+	if o.multiplier != nil || o.constantTime {
+		return nil, errFieldOption
+	}
+	inv := makeTable(len(table))
+	for x, row := range table {
+		for y, z := range row {
+			inv[y][z] = x
+		}
+	}
+	return o.applySeed(&tableDamm{table: table, inv: inv})
+}
+
+func (d *tableDamm) Generate(digits []int) (checkDigit int) {
+	for _, digit := range digits {
+		if uint(digit) >= uint(len(d.table)) {
+			return -1
+		}
+		checkDigit = d.table[checkDigit][digit]
+	}
+	return checkDigit
+}
+
+func (d *tableDamm) Verify(digits []int) bool {
+	return d.Generate(digits) == 0
+}
+
+func (d *tableDamm) Modulus() int {
+	return len(d.table)
+}
@@ -0,0 +1,54 @@
+package damm_test
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/go-oss/damm"
+)
+
+func TestNewTable(t *testing.T) {
+	t.Parallel()
+	d32 := damm.New32()
+	d, err := damm.NewTable(genMatrix(d32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := d.Modulus(); got != 32 {
+		t.Errorf("Modulus() = %d; want 32", got)
+	}
+	r := rand.New(rand.NewPCG(1, 2))
+	for range 100 {
+		digits := make([]int, r.IntN(20))
+		for i := range digits {
+			digits[i] = r.IntN(32)
+		}
+		if got, want := d.Generate(digits), d32.Generate(digits); got != want {
+			t.Fatalf("Generate(%v) = %d; want %d", digits, got, want)
+		}
+	}
+}
+
+func TestNewTable_order10(t *testing.T) {
+	t.Parallel()
+	table, err := damm.Quasigroup(context.Background(), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := damm.NewTable(table)
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload := decimal("5724031986")
+	assertSingleErrors(t, d, payload, 10)
+	assertTranspositions(t, d, payload)
+}
+
+func TestNewTable_invalid(t *testing.T) {
+	t.Parallel()
+	if _, err := damm.NewTable([][]int{{0, 1}, {1, 0}}); !errors.Is(err, damm.ErrTable) {
+		t.Errorf("NewTable error = %v; want ErrTable", err)
+	}
+}
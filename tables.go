@@ -0,0 +1,112 @@
+package damm
+
+// Tables of weakly totally anti-symmetric quasigroups, as printed by
+//
+//	go run ./cmd/quasigroup -order 36
+//	go run ./cmd/quasigroup -order 62
+//
+// They must never change, or codes checked with them would no longer verify.
+
+var table36 = [][]int{
+	{0, 7, 5, 3, 1, 8, 6, 4, 2, 18, 25, 23, 21, 19, 26, 24, 22, 20, 27, 34, 32, 30, 28, 35, 33, 31, 29, 9, 16, 14, 12, 10, 17, 15, 13, 11},
+	{2, 0, 7, 5, 3, 1, 8, 6, 4, 20, 18, 25, 23, 21, 19, 26, 24, 22, 29, 27, 34, 32, 30, 28, 35, 33, 31, 11, 9, 16, 14, 12, 10, 17, 15, 13},
+	{4, 2, 0, 7, 5, 3, 1, 8, 6, 22, 20, 18, 25, 23, 21, 19, 26, 24, 31, 29, 27, 34, 32, 30, 28, 35, 33, 13, 11, 9, 16, 14, 12, 10, 17, 15},
+	{6, 4, 2, 0, 7, 5, 3, 1, 8, 24, 22, 20, 18, 25, 23, 21, 19, 26, 33, 31, 29, 27, 34, 32, 30, 28, 35, 15, 13, 11, 9, 16, 14, 12, 10, 17},
+	{8, 6, 4, 2, 0, 7, 5, 3, 1, 26, 24, 22, 20, 18, 25, 23, 21, 19, 35, 33, 31, 29, 27, 34, 32, 30, 28, 17, 15, 13, 11, 9, 16, 14, 12, 10},
+	{1, 8, 6, 4, 2, 0, 7, 5, 3, 19, 26, 24, 22, 20, 18, 25, 23, 21, 28, 35, 33, 31, 29, 27, 34, 32, 30, 10, 17, 15, 13, 11, 9, 16, 14, 12},
+	{3, 1, 8, 6, 4, 2, 0, 7, 5, 21, 19, 26, 24, 22, 20, 18, 25, 23, 30, 28, 35, 33, 31, 29, 27, 34, 32, 12, 10, 17, 15, 13, 11, 9, 16, 14},
+	{5, 3, 1, 8, 6, 4, 2, 0, 7, 23, 21, 19, 26, 24, 22, 20, 18, 25, 32, 30, 28, 35, 33, 31, 29, 27, 34, 14, 12, 10, 17, 15, 13, 11, 9, 16},
+	{7, 5, 3, 1, 8, 6, 4, 2, 0, 25, 23, 21, 19, 26, 24, 22, 20, 18, 34, 32, 30, 28, 35, 33, 31, 29, 27, 16, 14, 12, 10, 17, 15, 13, 11, 9},
+	{18, 25, 23, 21, 19, 26, 24, 22, 20, 0, 7, 5, 3, 1, 8, 6, 4, 2, 9, 16, 14, 12, 10, 17, 15, 13, 11, 27, 34, 32, 30, 28, 35, 33, 31, 29},
+	{20, 18, 25, 23, 21, 19, 26, 24, 22, 2, 0, 7, 5, 3, 1, 8, 6, 4, 11, 9, 16, 14, 12, 10, 17, 15, 13, 29, 27, 34, 32, 30, 28, 35, 33, 31},
+	{22, 20, 18, 25, 23, 21, 19, 26, 24, 4, 2, 0, 7, 5, 3, 1, 8, 6, 13, 11, 9, 16, 14, 12, 10, 17, 15, 31, 29, 27, 34, 32, 30, 28, 35, 33},
+	{24, 22, 20, 18, 25, 23, 21, 19, 26, 6, 4, 2, 0, 7, 5, 3, 1, 8, 15, 13, 11, 9, 16, 14, 12, 10, 17, 33, 31, 29, 27, 34, 32, 30, 28, 35},
+	{26, 24, 22, 20, 18, 25, 23, 21, 19, 8, 6, 4, 2, 0, 7, 5, 3, 1, 17, 15, 13, 11, 9, 16, 14, 12, 10, 35, 33, 31, 29, 27, 34, 32, 30, 28},
+	{19, 26, 24, 22, 20, 18, 25, 23, 21, 1, 8, 6, 4, 2, 0, 7, 5, 3, 10, 17, 15, 13, 11, 9, 16, 14, 12, 28, 35, 33, 31, 29, 27, 34, 32, 30},
+	{21, 19, 26, 24, 22, 20, 18, 25, 23, 3, 1, 8, 6, 4, 2, 0, 7, 5, 12, 10, 17, 15, 13, 11, 9, 16, 14, 30, 28, 35, 33, 31, 29, 27, 34, 32},
+	{23, 21, 19, 26, 24, 22, 20, 18, 25, 5, 3, 1, 8, 6, 4, 2, 0, 7, 14, 12, 10, 17, 15, 13, 11, 9, 16, 32, 30, 28, 35, 33, 31, 29, 27, 34},
+	{25, 23, 21, 19, 26, 24, 22, 20, 18, 7, 5, 3, 1, 8, 6, 4, 2, 0, 16, 14, 12, 10, 17, 15, 13, 11, 9, 34, 32, 30, 28, 35, 33, 31, 29, 27},
+	{27, 34, 32, 30, 28, 35, 33, 31, 29, 9, 16, 14, 12, 10, 17, 15, 13, 11, 0, 7, 5, 3, 1, 8, 6, 4, 2, 18, 25, 23, 21, 19, 26, 24, 22, 20},
+	{29, 27, 34, 32, 30, 28, 35, 33, 31, 11, 9, 16, 14, 12, 10, 17, 15, 13, 2, 0, 7, 5, 3, 1, 8, 6, 4, 20, 18, 25, 23, 21, 19, 26, 24, 22},
+	{31, 29, 27, 34, 32, 30, 28, 35, 33, 13, 11, 9, 16, 14, 12, 10, 17, 15, 4, 2, 0, 7, 5, 3, 1, 8, 6, 22, 20, 18, 25, 23, 21, 19, 26, 24},
+	{33, 31, 29, 27, 34, 32, 30, 28, 35, 15, 13, 11, 9, 16, 14, 12, 10, 17, 6, 4, 2, 0, 7, 5, 3, 1, 8, 24, 22, 20, 18, 25, 23, 21, 19, 26},
+	{35, 33, 31, 29, 27, 34, 32, 30, 28, 17, 15, 13, 11, 9, 16, 14, 12, 10, 8, 6, 4, 2, 0, 7, 5, 3, 1, 26, 24, 22, 20, 18, 25, 23, 21, 19},
+	{28, 35, 33, 31, 29, 27, 34, 32, 30, 10, 17, 15, 13, 11, 9, 16, 14, 12, 1, 8, 6, 4, 2, 0, 7, 5, 3, 19, 26, 24, 22, 20, 18, 25, 23, 21},
+	{30, 28, 35, 33, 31, 29, 27, 34, 32, 12, 10, 17, 15, 13, 11, 9, 16, 14, 3, 1, 8, 6, 4, 2, 0, 7, 5, 21, 19, 26, 24, 22, 20, 18, 25, 23},
+	{32, 30, 28, 35, 33, 31, 29, 27, 34, 14, 12, 10, 17, 15, 13, 11, 9, 16, 5, 3, 1, 8, 6, 4, 2, 0, 7, 23, 21, 19, 26, 24, 22, 20, 18, 25},
+	{34, 32, 30, 28, 35, 33, 31, 29, 27, 16, 14, 12, 10, 17, 15, 13, 11, 9, 7, 5, 3, 1, 8, 6, 4, 2, 0, 25, 23, 21, 19, 26, 24, 22, 20, 18},
+	{9, 16, 14, 12, 10, 17, 15, 13, 11, 27, 34, 32, 30, 28, 35, 33, 31, 29, 18, 25, 23, 21, 19, 26, 24, 22, 20, 0, 7, 5, 3, 1, 8, 6, 4, 2},
+	{11, 9, 16, 14, 12, 10, 17, 15, 13, 29, 27, 34, 32, 30, 28, 35, 33, 31, 20, 18, 25, 23, 21, 19, 26, 24, 22, 2, 0, 7, 5, 3, 1, 8, 6, 4},
+	{13, 11, 9, 16, 14, 12, 10, 17, 15, 31, 29, 27, 34, 32, 30, 28, 35, 33, 22, 20, 18, 25, 23, 21, 19, 26, 24, 4, 2, 0, 7, 5, 3, 1, 8, 6},
+	{15, 13, 11, 9, 16, 14, 12, 10, 17, 33, 31, 29, 27, 34, 32, 30, 28, 35, 24, 22, 20, 18, 25, 23, 21, 19, 26, 6, 4, 2, 0, 7, 5, 3, 1, 8},
+	{17, 15, 13, 11, 9, 16, 14, 12, 10, 35, 33, 31, 29, 27, 34, 32, 30, 28, 26, 24, 22, 20, 18, 25, 23, 21, 19, 8, 6, 4, 2, 0, 7, 5, 3, 1},
+	{10, 17, 15, 13, 11, 9, 16, 14, 12, 28, 35, 33, 31, 29, 27, 34, 32, 30, 19, 26, 24, 22, 20, 18, 25, 23, 21, 1, 8, 6, 4, 2, 0, 7, 5, 3},
+	{12, 10, 17, 15, 13, 11, 9, 16, 14, 30, 28, 35, 33, 31, 29, 27, 34, 32, 21, 19, 26, 24, 22, 20, 18, 25, 23, 3, 1, 8, 6, 4, 2, 0, 7, 5},
+	{14, 12, 10, 17, 15, 13, 11, 9, 16, 32, 30, 28, 35, 33, 31, 29, 27, 34, 23, 21, 19, 26, 24, 22, 20, 18, 25, 5, 3, 1, 8, 6, 4, 2, 0, 7},
+	{16, 14, 12, 10, 17, 15, 13, 11, 9, 34, 32, 30, 28, 35, 33, 31, 29, 27, 25, 23, 21, 19, 26, 24, 22, 20, 18, 7, 5, 3, 1, 8, 6, 4, 2, 0},
+}
+
+var table62 = [][]int{
+	{0, 5, 2, 15, 20, 25, 6, 7, 8, 45, 10, 11, 60, 4, 9, 14, 19, 17, 18, 34, 39, 21, 49, 23, 24, 3, 26, 13, 28, 29, 30, 31, 32, 33, 48, 35, 58, 37, 38, 61, 40, 22, 27, 43, 44, 42, 47, 52, 57, 12, 50, 51, 16, 53, 54, 55, 36, 41, 46, 59, 56, 1},
+	{60, 0, 1, 10, 15, 20, 61, 16, 7, 40, 9, 28, 19, 41, 4, 24, 14, 46, 49, 51, 12, 58, 56, 22, 6, 59, 25, 44, 27, 21, 29, 30, 31, 32, 43, 34, 53, 36, 48, 3, 39, 17, 8, 2, 5, 37, 42, 47, 18, 13, 23, 26, 45, 52, 35, 54, 11, 33, 55, 50, 38, 57},
+	{51, 20, 0, 5, 25, 15, 4, 11, 61, 13, 8, 9, 50, 40, 60, 23, 45, 41, 44, 24, 29, 19, 55, 59, 1, 54, 7, 3, 26, 16, 28, 22, 30, 31, 38, 33, 48, 35, 43, 2, 49, 46, 17, 58, 42, 12, 34, 56, 47, 14, 18, 21, 6, 27, 52, 53, 10, 32, 36, 57, 37, 39},
+	{58, 51, 52, 0, 24, 46, 3, 6, 5, 30, 61, 18, 45, 50, 55, 60, 4, 14, 39, 19, 10, 48, 54, 20, 21, 49, 2, 59, 8, 26, 27, 17, 29, 23, 13, 32, 57, 34, 35, 1, 44, 7, 12, 40, 41, 11, 33, 37, 42, 15, 47, 16, 43, 22, 25, 28, 9, 31, 53, 56, 36, 38},
+	{57, 46, 59, 56, 0, 5, 2, 1, 4, 11, 6, 13, 40, 45, 50, 21, 60, 31, 14, 48, 19, 43, 29, 49, 52, 58, 22, 41, 3, 25, 9, 27, 28, 18, 12, 24, 38, 33, 34, 53, 36, 44, 7, 39, 51, 10, 32, 54, 15, 16, 8, 47, 42, 17, 20, 23, 61, 30, 26, 55, 35, 37},
+	{56, 41, 58, 51, 22, 0, 54, 57, 3, 20, 5, 8, 15, 37, 59, 50, 42, 12, 13, 9, 14, 16, 24, 44, 47, 39, 53, 40, 23, 1, 4, 26, 10, 28, 11, 19, 55, 25, 33, 60, 35, 43, 2, 38, 46, 61, 31, 27, 32, 17, 45, 6, 52, 48, 49, 18, 7, 29, 21, 30, 34, 36},
+	{31, 16, 57, 60, 51, 43, 0, 52, 55, 15, 4, 3, 14, 36, 40, 45, 41, 21, 12, 46, 9, 33, 19, 17, 42, 56, 48, 39, 54, 23, 24, 2, 5, 27, 61, 29, 28, 20, 32, 59, 34, 53, 58, 37, 38, 8, 30, 22, 13, 18, 44, 1, 47, 7, 10, 49, 6, 11, 50, 25, 26, 35},
+	{26, 15, 32, 41, 46, 42, 60, 0, 1, 10, 56, 59, 13, 35, 57, 18, 40, 16, 61, 45, 4, 14, 50, 34, 17, 29, 43, 38, 49, 52, 55, 24, 25, 3, 9, 28, 23, 30, 31, 58, 33, 48, 53, 36, 37, 7, 12, 51, 22, 19, 54, 44, 39, 2, 47, 8, 5, 6, 11, 20, 21, 27},
+	{21, 14, 27, 58, 19, 41, 59, 42, 0, 5, 2, 54, 61, 34, 30, 35, 39, 11, 10, 55, 60, 23, 9, 15, 16, 24, 18, 37, 44, 47, 50, 53, 56, 25, 8, 4, 52, 29, 13, 33, 32, 40, 1, 28, 36, 6, 7, 12, 17, 20, 49, 43, 38, 45, 46, 3, 57, 26, 48, 51, 31, 22},
+	{52, 61, 22, 31, 36, 40, 34, 59, 60, 0, 1, 2, 11, 33, 25, 16, 38, 6, 9, 50, 55, 18, 4, 24, 15, 53, 17, 29, 19, 20, 45, 48, 51, 54, 7, 26, 13, 5, 8, 28, 14, 39, 43, 23, 35, 58, 27, 49, 12, 21, 41, 42, 37, 44, 56, 46, 3, 57, 47, 10, 30, 32},
+	{11, 12, 53, 26, 17, 39, 29, 32, 35, 56, 0, 44, 10, 15, 54, 25, 30, 1, 8, 42, 3, 13, 47, 19, 61, 14, 16, 24, 18, 37, 20, 21, 46, 49, 59, 55, 50, 27, 28, 23, 9, 38, 60, 33, 34, 4, 58, 48, 7, 22, 40, 41, 36, 43, 51, 45, 2, 52, 57, 5, 6, 31},
+	{6, 11, 12, 55, 26, 31, 24, 27, 30, 4, 36, 0, 9, 10, 15, 20, 25, 57, 60, 41, 45, 8, 46, 14, 13, 51, 61, 34, 17, 18, 19, 38, 21, 22, 5, 50, 49, 56, 59, 54, 29, 37, 33, 32, 16, 3, 53, 58, 2, 23, 39, 40, 35, 42, 43, 44, 1, 47, 52, 48, 28, 7},
+	{49, 10, 7, 16, 21, 26, 55, 56, 25, 46, 31, 34, 0, 30, 52, 15, 35, 5, 6, 40, 1, 3, 45, 9, 12, 50, 14, 33, 61, 27, 18, 19, 20, 39, 4, 23, 59, 51, 54, 13, 60, 36, 28, 8, 11, 2, 48, 53, 58, 24, 38, 32, 17, 41, 42, 43, 37, 22, 44, 47, 57, 29},
+	{48, 1, 50, 53, 16, 36, 14, 17, 56, 2, 26, 29, 7, 0, 51, 10, 34, 47, 5, 39, 35, 59, 44, 4, 11, 60, 13, 9, 15, 22, 61, 28, 19, 20, 3, 40, 54, 24, 49, 8, 55, 18, 57, 30, 31, 38, 23, 45, 6, 25, 37, 27, 12, 33, 41, 42, 32, 21, 43, 46, 52, 58},
+	{47, 8, 49, 52, 11, 35, 9, 54, 15, 36, 57, 58, 6, 56, 0, 5, 10, 3, 4, 38, 30, 7, 43, 60, 2, 55, 12, 31, 14, 17, 16, 23, 61, 29, 39, 21, 46, 41, 24, 51, 50, 13, 18, 59, 1, 33, 22, 44, 48, 26, 19, 37, 32, 28, 40, 34, 27, 20, 42, 45, 25, 53},
+	{46, 7, 48, 1, 6, 11, 52, 53, 10, 31, 16, 19, 5, 51, 56, 0, 32, 37, 40, 20, 59, 49, 35, 8, 9, 47, 3, 60, 13, 12, 15, 18, 17, 24, 34, 30, 45, 22, 23, 50, 25, 33, 55, 54, 57, 28, 21, 43, 4, 27, 14, 36, 2, 38, 39, 29, 58, 61, 41, 44, 42, 26},
+	{45, 6, 47, 57, 1, 33, 51, 2, 53, 60, 11, 56, 41, 26, 48, 9, 0, 32, 35, 15, 20, 5, 30, 50, 8, 46, 10, 55, 4, 7, 14, 13, 16, 19, 29, 25, 44, 31, 22, 49, 24, 3, 54, 27, 52, 59, 61, 42, 38, 28, 34, 12, 58, 37, 21, 39, 17, 18, 40, 36, 23, 43},
+	{37, 42, 46, 49, 10, 1, 50, 58, 52, 21, 54, 55, 36, 25, 47, 51, 56, 0, 30, 35, 57, 39, 40, 6, 7, 45, 9, 28, 11, 2, 5, 8, 15, 14, 60, 20, 43, 26, 61, 48, 23, 59, 3, 44, 27, 18, 19, 41, 33, 29, 4, 34, 53, 13, 16, 38, 12, 17, 22, 31, 32, 24},
+	{32, 37, 38, 48, 52, 57, 49, 50, 51, 58, 53, 4, 31, 24, 46, 7, 29, 22, 0, 5, 56, 34, 39, 40, 43, 44, 8, 45, 10, 11, 12, 3, 6, 9, 19, 15, 42, 21, 20, 47, 61, 54, 59, 25, 26, 13, 18, 23, 1, 30, 60, 2, 28, 35, 36, 14, 55, 16, 17, 41, 27, 33},
+	{42, 32, 33, 47, 8, 30, 48, 49, 50, 57, 52, 60, 1, 61, 45, 41, 46, 59, 20, 0, 5, 2, 15, 35, 38, 43, 44, 26, 9, 53, 11, 12, 13, 4, 14, 10, 24, 16, 19, 39, 21, 29, 51, 34, 25, 56, 17, 18, 23, 31, 55, 58, 27, 3, 6, 36, 54, 7, 37, 40, 22, 28},
+	{41, 2, 43, 46, 42, 47, 40, 48, 49, 6, 51, 52, 21, 22, 44, 36, 27, 58, 15, 56, 0, 24, 37, 3, 33, 25, 39, 35, 45, 9, 10, 54, 12, 13, 57, 5, 19, 11, 18, 34, 20, 28, 50, 29, 61, 55, 8, 38, 60, 32, 30, 31, 26, 59, 1, 4, 53, 14, 7, 16, 17, 23},
+	{17, 22, 42, 45, 37, 28, 35, 47, 41, 1, 50, 51, 16, 21, 26, 4, 36, 7, 58, 31, 53, 0, 5, 25, 3, 20, 34, 30, 40, 43, 46, 10, 11, 55, 56, 14, 39, 6, 9, 44, 19, 27, 49, 24, 23, 54, 15, 8, 59, 33, 29, 48, 61, 32, 57, 60, 52, 13, 2, 38, 12, 18},
+	{39, 17, 18, 27, 5, 37, 45, 46, 36, 54, 42, 50, 59, 20, 21, 26, 31, 2, 57, 30, 52, 60, 0, 1, 23, 40, 4, 25, 35, 38, 41, 44, 47, 11, 55, 56, 9, 15, 16, 43, 10, 61, 48, 19, 22, 53, 14, 3, 8, 34, 28, 29, 24, 49, 32, 33, 51, 12, 58, 6, 7, 13},
+	{7, 60, 40, 22, 27, 32, 44, 28, 46, 53, 37, 49, 58, 11, 41, 2, 26, 55, 56, 29, 51, 9, 34, 0, 18, 10, 24, 20, 5, 6, 36, 39, 42, 45, 54, 12, 4, 57, 15, 19, 17, 25, 47, 14, 21, 52, 13, 59, 3, 35, 61, 38, 23, 30, 31, 50, 43, 48, 33, 1, 16, 8},
+	{2, 59, 8, 42, 3, 27, 20, 23, 45, 52, 47, 48, 57, 18, 11, 1, 21, 54, 55, 61, 50, 4, 51, 10, 0, 5, 19, 15, 25, 28, 6, 7, 37, 40, 53, 46, 60, 13, 14, 41, 16, 24, 29, 9, 12, 44, 49, 34, 56, 36, 26, 33, 22, 39, 30, 31, 38, 43, 32, 35, 58, 17},
+	{36, 58, 3, 12, 2, 22, 42, 43, 21, 51, 46, 30, 56, 17, 6, 11, 16, 53, 54, 27, 49, 57, 32, 5, 60, 0, 1, 10, 20, 4, 26, 29, 7, 8, 45, 41, 35, 47, 50, 9, 15, 23, 24, 18, 19, 39, 44, 33, 55, 37, 25, 28, 13, 34, 61, 40, 48, 38, 31, 52, 14, 59},
+	{53, 57, 37, 7, 12, 17, 10, 13, 43, 50, 22, 25, 55, 16, 1, 6, 11, 52, 46, 26, 31, 56, 41, 58, 59, 36, 0, 19, 2, 3, 21, 5, 27, 30, 40, 9, 34, 42, 45, 4, 51, 14, 44, 60, 18, 49, 39, 32, 54, 38, 24, 23, 20, 29, 28, 35, 47, 8, 61, 33, 48, 15},
+	{34, 56, 54, 2, 7, 12, 5, 8, 11, 32, 44, 45, 47, 52, 37, 59, 20, 51, 41, 25, 26, 55, 36, 57, 58, 35, 60, 0, 1, 13, 3, 4, 22, 6, 50, 31, 33, 10, 40, 38, 46, 21, 14, 16, 17, 48, 9, 61, 53, 39, 15, 18, 19, 24, 27, 30, 23, 28, 29, 42, 43, 49},
+	{43, 48, 35, 38, 60, 21, 39, 3, 6, 27, 12, 15, 42, 47, 36, 58, 1, 33, 51, 16, 46, 54, 31, 56, 57, 34, 59, 17, 0, 8, 2, 14, 4, 5, 49, 7, 61, 32, 10, 55, 41, 20, 9, 50, 53, 24, 29, 30, 52, 40, 22, 13, 18, 19, 26, 25, 45, 23, 28, 37, 11, 44},
+	{38, 43, 44, 37, 59, 2, 56, 39, 40, 47, 7, 10, 52, 42, 35, 57, 18, 28, 50, 23, 16, 53, 26, 55, 49, 61, 58, 51, 60, 0, 1, 9, 3, 15, 25, 6, 31, 8, 30, 36, 11, 19, 4, 45, 48, 46, 24, 29, 34, 41, 21, 22, 54, 14, 17, 20, 13, 5, 27, 32, 33, 12},
+	{33, 53, 39, 36, 58, 19, 37, 38, 57, 17, 41, 5, 51, 12, 61, 56, 52, 48, 26, 22, 11, 35, 21, 54, 44, 32, 50, 46, 59, 60, 0, 1, 2, 10, 47, 16, 30, 7, 25, 45, 31, 55, 40, 13, 43, 14, 6, 28, 29, 42, 20, 3, 49, 23, 24, 15, 8, 4, 18, 27, 9, 34},
+	{28, 52, 34, 61, 57, 53, 46, 37, 38, 12, 58, 41, 27, 32, 33, 55, 47, 18, 48, 21, 6, 30, 16, 36, 54, 31, 45, 14, 51, 59, 60, 0, 1, 2, 15, 11, 29, 17, 7, 40, 26, 50, 39, 35, 13, 9, 5, 19, 49, 43, 56, 20, 44, 4, 23, 24, 42, 3, 25, 22, 8, 10},
+	{23, 28, 29, 34, 56, 48, 41, 61, 47, 7, 39, 40, 49, 27, 32, 37, 15, 13, 16, 57, 42, 50, 25, 31, 53, 30, 55, 36, 46, 58, 52, 60, 0, 1, 10, 3, 20, 12, 6, 35, 8, 45, 38, 11, 33, 43, 4, 26, 19, 44, 51, 54, 14, 21, 22, 5, 59, 2, 24, 17, 18, 9},
+	{18, 50, 24, 33, 38, 16, 36, 35, 42, 43, 48, 39, 17, 9, 31, 32, 37, 8, 11, 52, 41, 20, 6, 51, 29, 21, 54, 12, 56, 57, 47, 59, 53, 0, 44, 2, 27, 4, 5, 30, 7, 15, 61, 10, 28, 60, 3, 25, 14, 45, 46, 49, 34, 55, 58, 22, 40, 1, 23, 26, 13, 19},
+	{27, 18, 19, 32, 33, 38, 31, 34, 37, 42, 43, 61, 12, 8, 22, 52, 13, 44, 45, 47, 40, 15, 23, 21, 51, 28, 30, 11, 55, 39, 57, 58, 48, 60, 0, 1, 26, 3, 4, 25, 6, 35, 36, 20, 10, 41, 2, 24, 9, 46, 16, 17, 29, 50, 53, 56, 49, 54, 59, 7, 5, 14},
+	{8, 13, 28, 23, 53, 14, 26, 33, 32, 41, 38, 37, 46, 7, 29, 22, 12, 43, 1, 17, 61, 10, 57, 16, 19, 27, 52, 21, 31, 34, 56, 40, 58, 59, 42, 0, 25, 2, 3, 20, 5, 30, 35, 15, 9, 50, 55, 60, 45, 47, 36, 39, 11, 18, 48, 51, 44, 49, 54, 24, 4, 6},
+	{25, 47, 9, 30, 23, 13, 21, 24, 27, 61, 33, 36, 2, 6, 28, 17, 22, 42, 43, 37, 38, 46, 52, 11, 14, 26, 20, 16, 53, 54, 32, 35, 57, 41, 51, 60, 0, 1, 56, 29, 4, 12, 34, 7, 8, 45, 50, 55, 44, 48, 31, 15, 10, 40, 18, 19, 39, 59, 49, 58, 3, 5},
+	{59, 3, 26, 29, 18, 23, 30, 31, 22, 39, 28, 35, 44, 5, 27, 12, 17, 61, 52, 32, 37, 45, 20, 47, 48, 1, 15, 8, 21, 24, 54, 55, 33, 36, 46, 42, 56, 0, 51, 10, 57, 11, 25, 6, 7, 40, 60, 50, 43, 49, 13, 14, 9, 16, 38, 41, 34, 58, 19, 53, 2, 4},
+	{54, 45, 60, 28, 13, 18, 11, 30, 31, 38, 23, 26, 53, 58, 2, 48, 9, 40, 47, 14, 36, 44, 42, 46, 4, 57, 49, 7, 16, 19, 22, 25, 55, 56, 41, 37, 51, 43, 0, 27, 52, 10, 32, 5, 6, 35, 59, 20, 61, 50, 12, 24, 8, 15, 33, 17, 29, 34, 39, 21, 1, 3},
+	{22, 54, 55, 3, 49, 10, 28, 29, 12, 37, 32, 33, 48, 53, 58, 47, 8, 39, 42, 13, 27, 61, 18, 45, 46, 52, 5, 6, 50, 14, 17, 20, 23, 26, 36, 57, 21, 38, 60, 0, 1, 9, 31, 4, 59, 30, 35, 40, 41, 51, 11, 19, 7, 25, 15, 16, 24, 56, 34, 43, 44, 2},
+	{44, 49, 23, 59, 48, 9, 1, 4, 29, 28, 13, 32, 43, 2, 53, 46, 7, 38, 37, 12, 34, 42, 17, 61, 55, 22, 47, 5, 6, 50, 51, 15, 18, 21, 31, 27, 41, 58, 36, 56, 0, 8, 30, 3, 54, 25, 57, 35, 40, 52, 10, 11, 60, 20, 14, 26, 33, 24, 16, 19, 39, 45},
+	{20, 44, 45, 54, 47, 8, 57, 60, 2, 35, 30, 31, 38, 1, 23, 61, 6, 29, 32, 11, 33, 41, 27, 43, 50, 42, 56, 4, 48, 49, 7, 51, 52, 16, 26, 22, 36, 28, 58, 24, 37, 0, 5, 46, 3, 34, 25, 17, 39, 53, 9, 10, 55, 12, 13, 21, 14, 19, 15, 18, 59, 40},
+	{19, 39, 21, 24, 61, 7, 25, 55, 58, 34, 3, 6, 33, 38, 43, 44, 5, 36, 27, 10, 32, 40, 22, 42, 45, 37, 51, 47, 57, 48, 49, 50, 8, 52, 35, 17, 18, 23, 26, 46, 59, 56, 0, 41, 2, 15, 20, 16, 30, 54, 1, 9, 4, 11, 12, 13, 31, 53, 14, 28, 29, 60},
+	{29, 34, 20, 44, 45, 6, 47, 25, 26, 33, 59, 1, 28, 60, 38, 43, 48, 35, 36, 2, 7, 31, 14, 41, 40, 19, 46, 42, 52, 61, 58, 49, 50, 51, 16, 53, 17, 18, 21, 22, 27, 5, 56, 0, 39, 32, 54, 15, 37, 55, 57, 8, 3, 10, 11, 12, 4, 9, 13, 23, 24, 30},
+	{24, 29, 30, 39, 44, 49, 23, 45, 48, 8, 27, 57, 37, 28, 20, 42, 43, 34, 17, 58, 2, 38, 13, 32, 35, 18, 41, 1, 47, 46, 53, 61, 59, 50, 33, 52, 16, 54, 55, 21, 22, 4, 26, 31, 0, 5, 10, 14, 36, 56, 6, 7, 40, 9, 3, 11, 60, 51, 12, 15, 19, 25},
+	{16, 38, 25, 21, 43, 44, 22, 40, 24, 3, 49, 27, 18, 23, 19, 33, 2, 9, 34, 7, 58, 37, 12, 39, 30, 17, 36, 32, 42, 45, 48, 47, 54, 61, 6, 51, 15, 53, 11, 31, 56, 41, 46, 26, 29, 0, 52, 13, 35, 57, 5, 50, 1, 8, 59, 10, 28, 60, 4, 14, 55, 20},
+	{15, 19, 17, 20, 34, 3, 32, 22, 23, 59, 25, 47, 35, 57, 18, 40, 33, 4, 7, 6, 28, 36, 11, 38, 39, 16, 31, 27, 37, 44, 43, 46, 49, 48, 1, 61, 14, 52, 53, 26, 12, 2, 41, 21, 24, 29, 0, 5, 10, 58, 42, 45, 30, 51, 8, 9, 50, 55, 60, 13, 54, 56},
+	{14, 36, 16, 19, 41, 34, 27, 21, 33, 29, 24, 42, 8, 13, 17, 39, 28, 60, 2, 43, 48, 11, 10, 37, 20, 15, 40, 22, 32, 35, 38, 45, 44, 47, 30, 49, 6, 61, 1, 18, 54, 31, 23, 57, 58, 51, 56, 0, 5, 59, 3, 4, 25, 46, 7, 52, 26, 50, 9, 12, 53, 55},
+	{13, 9, 15, 18, 40, 29, 19, 20, 28, 49, 34, 24, 3, 55, 16, 38, 23, 30, 31, 4, 43, 6, 53, 12, 37, 7, 21, 58, 41, 42, 33, 36, 39, 46, 52, 48, 1, 50, 57, 17, 2, 26, 22, 56, 14, 27, 51, 10, 0, 60, 32, 35, 59, 5, 44, 47, 25, 45, 8, 11, 61, 54},
+	{12, 4, 14, 17, 39, 24, 18, 19, 20, 44, 29, 23, 32, 3, 8, 13, 59, 50, 53, 33, 25, 1, 48, 7, 10, 2, 38, 57, 22, 41, 42, 43, 34, 37, 28, 47, 11, 49, 52, 16, 58, 60, 21, 55, 56, 26, 46, 9, 31, 0, 27, 30, 15, 36, 5, 6, 35, 40, 45, 54, 51, 61},
+	{55, 33, 13, 9, 14, 60, 17, 18, 19, 26, 21, 22, 54, 59, 3, 8, 58, 45, 29, 28, 24, 32, 7, 2, 5, 12, 11, 56, 39, 40, 23, 42, 43, 44, 27, 38, 10, 48, 47, 15, 53, 16, 20, 61, 4, 36, 41, 46, 51, 1, 0, 25, 57, 31, 34, 37, 30, 35, 6, 49, 50, 52},
+	{50, 55, 56, 4, 9, 59, 16, 10, 18, 25, 20, 21, 30, 54, 13, 3, 57, 27, 28, 1, 23, 52, 38, 33, 34, 11, 6, 61, 12, 15, 40, 41, 24, 43, 37, 45, 47, 39, 42, 14, 48, 58, 19, 53, 60, 31, 36, 7, 46, 2, 17, 0, 5, 26, 29, 32, 22, 44, 35, 8, 49, 51},
+	{9, 31, 51, 14, 4, 58, 15, 5, 17, 24, 19, 20, 29, 49, 12, 34, 61, 26, 38, 18, 22, 47, 33, 53, 56, 48, 35, 54, 7, 10, 13, 16, 41, 42, 32, 44, 8, 46, 37, 57, 43, 6, 11, 52, 55, 23, 45, 36, 28, 3, 59, 60, 0, 1, 2, 27, 21, 25, 30, 39, 40, 50},
+	{40, 30, 10, 13, 35, 61, 58, 15, 16, 23, 18, 12, 39, 44, 49, 54, 55, 25, 33, 60, 21, 29, 28, 48, 32, 9, 57, 53, 36, 5, 8, 11, 14, 17, 24, 43, 37, 45, 46, 52, 38, 1, 6, 51, 50, 22, 26, 31, 27, 4, 7, 59, 56, 0, 19, 2, 20, 42, 3, 34, 47, 41},
+	{35, 40, 41, 50, 55, 56, 53, 14, 59, 22, 17, 7, 34, 39, 10, 49, 54, 24, 25, 8, 13, 28, 3, 30, 31, 38, 33, 52, 58, 36, 37, 6, 9, 12, 23, 18, 32, 44, 27, 11, 47, 57, 16, 42, 45, 21, 43, 4, 26, 5, 2, 61, 51, 60, 0, 1, 19, 15, 20, 29, 46, 48},
+	{30, 35, 36, 11, 50, 55, 12, 51, 54, 14, 60, 17, 26, 48, 39, 31, 53, 23, 24, 3, 8, 27, 2, 29, 41, 33, 32, 43, 34, 56, 59, 37, 38, 7, 22, 13, 5, 19, 44, 42, 28, 52, 15, 49, 40, 20, 16, 21, 25, 6, 58, 57, 46, 61, 9, 0, 18, 10, 1, 4, 45, 47},
+	{5, 27, 31, 40, 32, 54, 43, 12, 13, 9, 55, 16, 25, 29, 34, 30, 44, 15, 23, 59, 18, 26, 1, 28, 36, 6, 42, 50, 33, 51, 35, 57, 60, 38, 21, 8, 22, 14, 17, 37, 45, 47, 52, 48, 49, 19, 11, 2, 24, 7, 53, 56, 41, 58, 4, 61, 0, 39, 10, 3, 20, 46},
+	{4, 26, 6, 35, 31, 45, 38, 41, 44, 19, 14, 53, 24, 46, 7, 29, 51, 10, 22, 54, 17, 25, 61, 27, 28, 23, 37, 49, 43, 33, 34, 52, 36, 58, 20, 39, 3, 9, 12, 32, 18, 42, 13, 47, 30, 1, 40, 11, 16, 8, 48, 55, 50, 57, 60, 59, 56, 0, 5, 2, 15, 21},
+	{3, 25, 5, 8, 30, 52, 33, 36, 39, 18, 45, 14, 23, 19, 24, 28, 50, 20, 21, 49, 54, 17, 60, 26, 27, 4, 29, 48, 38, 32, 44, 34, 35, 53, 2, 59, 12, 40, 41, 7, 13, 51, 42, 22, 47, 57, 1, 6, 11, 9, 43, 46, 31, 56, 55, 58, 15, 37, 0, 61, 10, 16},
+	{61, 24, 4, 25, 29, 51, 8, 9, 34, 55, 40, 43, 22, 14, 5, 27, 49, 19, 3, 44, 15, 12, 59, 18, 26, 13, 28, 23, 30, 31, 39, 33, 45, 35, 58, 54, 7, 60, 2, 6, 42, 32, 37, 17, 20, 16, 38, 1, 21, 10, 52, 53, 48, 47, 50, 57, 46, 36, 56, 0, 41, 11},
+	{1, 23, 61, 6, 28, 50, 7, 26, 9, 16, 35, 38, 4, 43, 14, 19, 24, 56, 59, 53, 44, 22, 58, 13, 25, 8, 27, 18, 29, 30, 31, 32, 40, 34, 17, 36, 2, 55, 39, 5, 3, 49, 10, 12, 15, 47, 37, 57, 20, 11, 33, 52, 21, 54, 45, 48, 41, 46, 51, 60, 0, 42},
+	{10, 21, 11, 43, 54, 4, 13, 44, 14, 48, 15, 46, 20, 31, 42, 53, 3, 49, 19, 36, 47, 51, 8, 52, 22, 41, 23, 2, 24, 55, 25, 56, 26, 57, 18, 58, 40, 59, 29, 12, 30, 34, 45, 1, 32, 17, 28, 39, 50, 61, 35, 5, 33, 6, 37, 7, 16, 27, 38, 9, 60, 0},
+}
@@ -0,0 +1,206 @@
+package damm
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// Match is a code found by a TextScanner.
+type Match struct {
+	Offset int64  // byte offset of the code in the input
+	Text   string // the code as found, with separators
+	Code   string // the symbols of the code in canonical form
+	Err    error  // nil for valid codes, or the error of Codec.Verify
+
+	// Suggestions lists for a near miss the valid codes that Code is one
+	// adjacent transposition or insertion away from, most likely first.
+	// Their offsets refer to Code.
+	Suggestions []Suggestion
+}
+
+// TextScanner finds checked codes in text such as emails and logs. A
+// candidate is a word, that is a maximal run of ASCII letters, digits,
+// alphabet symbols and separators, which after trimming separators at its
+// ends consists of alphabet symbols, with single separators between
+// groups, and has between MinLen and MaxLen symbols.
+//
+// Candidates that fail verification are near misses if they are written
+// in groups with separators and are one adjacent transposition or
+// insertion away from a valid code. Every candidate is one substitution
+// and one deletion away from valid codes, as any symbol can be changed, or
+// a symbol added anywhere, to make it valid. About (2n-1)/q of random words
+// of n symbols are a transposition or insertion away from one, where q is
+// the modulus, which is a third of words of 6 symbols under Crockford, so
+// words without separators, as most words of prose are, are never near
+// misses.
+type TextScanner struct {
+	Codec *Codec
+
+	// MinLen and MaxLen bound the number of symbols of a code, including
+	// the check symbol. MinLen is at least 2 and MaxLen zero means no
+	// bound.
+	MinLen, MaxLen int
+
+	// Separators lists the characters allowed between groups of symbols.
+	// Characters of the alphabet are taken as symbols.
+	Separators string
+
+	// Groups lists the group sizes of a code with separators from the
+	// left, as for Formatter; the last size repeats. Codes without
+	// separators match any Groups. Sizes must be positive.
+	Groups []int
+
+	// NearMisses, if not nil, is called for each near miss, in the order
+	// of the input as for found. It needs a Codec whose Damm is made by
+	// this package.
+	NearMisses func(Match) error
+}
+
+// maxWord bounds the bytes of a word kept by Scan, so that a long run of
+// letters does not grow without bound.
+const maxWord = 4096
+
+// Scan reads r to the end and calls found for each code, in the order of
+// the input. It stops at the first error of r, found or s.NearMisses, which
+// it returns. It returns ErrDigest if s.NearMisses is set and the Damm of
+// s.Codec was not made by this package.
+func (s *TextScanner) Scan(r io.Reader, found func(Match) error) error {
+	if err := checkGroups(s.Groups); err != nil {
+		return err
+	}
+	if _, ok := s.Codec.d.(stepper); s.NearMisses != nil && !ok {
+		return ErrDigest
+	}
+	br := bufio.NewReader(r)
+	var word []byte
+	var start, pos int64
+	long := false
+	emit := func() error {
+		defer func() {
+			word, long = word[:0], false
+		}()
+		if long || len(word) == 0 {
+			return nil
+		}
+		m, ok := s.match(word, start)
+		switch {
+		case !ok:
+			return nil
+		case m.Err != nil:
+			return s.NearMisses(m)
+		}
+		return found(m)
+	}
+	for {
+		c, err := br.ReadByte()
+		if err == io.EOF {
+			return emit()
+		}
+		if err != nil {
+			return err
+		}
+		if s.isWordByte(c) {
+			if len(word) == 0 {
+				start = pos
+			}
+			if len(word) < maxWord {
+				word = append(word, c)
+			} else {
+				long = true
+			}
+		} else if err := emit(); err != nil {
+			return err
+		}
+		pos++
+	}
+}
+
+func (s *TextScanner) isWordByte(c byte) bool {
+	if _, ok := s.Codec.a.Value(c); ok {
+		return true
+	}
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || s.isSeparator(c)
+}
+
+func (s *TextScanner) isSeparator(c byte) bool {
+	if _, ok := s.Codec.a.Value(c); ok {
+		return false
+	}
+	return strings.IndexByte(s.Separators, c) >= 0
+}
+
+// match returns the match for word found at offset, if it is a candidate
+// and valid, or a near miss and NearMisses is set.
+func (s *TextScanner) match(word []byte, offset int64) (Match, bool) {
+	i, j := 0, len(word)
+	for i < j && s.isSeparator(word[i]) {
+		i++
+	}
+	for j > i && s.isSeparator(word[j-1]) {
+		j--
+	}
+	text := word[i:j]
+	code := make([]byte, 0, len(text))
+	var groups []int
+	size := 0
+	for _, c := range text {
+		if s.isSeparator(c) {
+			if size == 0 {
+				return Match{}, false
+			}
+			groups, size = append(groups, size), 0
+			continue
+		}
+		if _, ok := s.Codec.a.Value(c); !ok {
+			return Match{}, false
+		}
+		code = append(code, c)
+		size++
+	}
+	if len(groups) > 0 && !s.grouped(append(groups, size)) {
+		return Match{}, false
+	}
+	if len(code) < max(s.MinLen, 2) || s.MaxLen > 0 && len(code) > s.MaxLen {
+		return Match{}, false
+	}
+	digits, _ := s.Codec.a.Decode(string(code))
+	canonical, _ := s.Codec.a.Encode(digits)
+	m := Match{Offset: offset + int64(i), Text: string(text), Code: canonical}
+	if m.Err = s.Codec.Verify(canonical); m.Err == nil {
+		return m, true
+	}
+	if s.NearMisses == nil || len(groups) == 0 {
+		return Match{}, false
+	}
+	m.Suggestions = s.nearMiss(canonical)
+	return m, len(m.Suggestions) > 0
+}
+
+// nearMiss returns the valid codes within the length bounds that code is
+// one adjacent transposition or insertion away from, most likely first.
+func (s *TextScanner) nearMiss(code string) []Suggestion {
+	var near []Suggestion
+	for _, c := range candidates(s.Codec, s.Codec.d.(stepper), code) {
+		n := len(c.Code)
+		if (c.Edit == Transposition || c.Edit == Insertion) && n >= max(s.MinLen, 2) && (s.MaxLen == 0 || n <= s.MaxLen) {
+			near = append(near, c)
+		}
+	}
+	return rank(near, code, nil, 0)
+}
+
+// grouped reports whether group sizes follow s.Groups. The last group may
+// be shorter, as Formatter writes it.
+func (s *TextScanner) grouped(sizes []int) bool {
+	if len(s.Groups) == 0 {
+		return true
+	}
+	for n, size := range sizes {
+		want := s.Groups[min(n, len(s.Groups)-1)]
+		if size != want && (n < len(sizes)-1 || size > want) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,203 @@
+package damm_test
+
+import (
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/go-oss/damm"
+)
+
+func scanAll(t *testing.T, s *damm.TextScanner, text string) []damm.Match {
+	t.Helper()
+	var matches []damm.Match
+	err := s.Scan(iotest.OneByteReader(strings.NewReader(text)), func(m damm.Match) error {
+		matches = append(matches, m)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return matches
+}
+
+func TestTextScanner(t *testing.T) {
+	t.Parallel()
+	c := mustCodec(t, damm.New32(), damm.Crockford)
+	text := "Hi, my key 7K3Q-Z0M9-TP4P stopped working (was c0ffeea). Also 7K3QZ0M9TP4Q? Thanks, WORLD3."
+	for _, tt := range []struct {
+		name    string
+		scanner damm.TextScanner
+		want    []damm.Match
+	}{
+		{
+			"valid",
+			damm.TextScanner{Codec: c, MinLen: 6, Separators: "-"},
+			[]damm.Match{
+				{Offset: 11, Text: "7K3Q-Z0M9-TP4P", Code: "7K3QZ0M9TP4P"},
+				{Offset: 47, Text: "c0ffeea", Code: "C0FFEEA"},
+				{Offset: 84, Text: "WORLD3", Code: "W0R1D3"},
+			},
+		},
+		{
+			"lengths",
+			damm.TextScanner{Codec: c, MinLen: 7, MaxLen: 12, Separators: "-"},
+			[]damm.Match{
+				{Offset: 11, Text: "7K3Q-Z0M9-TP4P", Code: "7K3QZ0M9TP4P"},
+				{Offset: 47, Text: "c0ffeea", Code: "C0FFEEA"},
+			},
+		},
+		{
+			"no separators",
+			damm.TextScanner{Codec: c, MinLen: 6},
+			[]damm.Match{
+				{Offset: 47, Text: "c0ffeea", Code: "C0FFEEA"},
+				{Offset: 84, Text: "WORLD3", Code: "W0R1D3"},
+			},
+		},
+		{
+			"groups",
+			damm.TextScanner{Codec: c, MinLen: 6, Separators: "-", Groups: []int{3}},
+			[]damm.Match{
+				{Offset: 47, Text: "c0ffeea", Code: "C0FFEEA"},
+				{Offset: 84, Text: "WORLD3", Code: "W0R1D3"},
+			},
+		},
+	} {
+		got := scanAll(t, &tt.scanner, text)
+		if !slices.EqualFunc(got, tt.want, equalMatch) {
+			t.Errorf("%s: Scan = %+v; want %+v", tt.name, got, tt.want)
+		}
+		for _, m := range got {
+			if text[m.Offset:m.Offset+int64(len(m.Text))] != m.Text {
+				t.Errorf("%s: text at offset %d = %q; want %q", tt.name, m.Offset, text[m.Offset:], m.Text)
+			}
+		}
+	}
+}
+
+func equalMatch(a, b damm.Match) bool {
+	if a.Offset != b.Offset || a.Text != b.Text || a.Code != b.Code || !slices.Equal(a.Suggestions, b.Suggestions) {
+		return false
+	}
+	if a.Err == nil || b.Err == nil {
+		return a.Err == nil && b.Err == nil
+	}
+	return a.Err.Error() == b.Err.Error()
+}
+
+func TestTextScanner_nearMisses(t *testing.T) {
+	t.Parallel()
+	c := mustCodec(t, damm.New32(), damm.Crockford)
+	text := "Key 7K3Q-Z0M9-PT4P, then 7K3Q-Z0MM9-TP4P, not 7K3QZ0M9TP4Q; WORLD3."
+	var near []damm.Match
+	s := &damm.TextScanner{Codec: c, MinLen: 10, Separators: "-", NearMisses: func(m damm.Match) error {
+		near = append(near, m)
+		return nil
+	}}
+	var found []damm.Match
+	err := s.Scan(strings.NewReader(text), func(m damm.Match) error {
+		found = append(found, m)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 0 {
+		t.Errorf("Scan found %+v; want none", found)
+	}
+	for _, tt := range []struct {
+		offset int64
+		text   string
+		edit   damm.Edit
+		at     int
+	}{
+		{4, "7K3Q-Z0M9-PT4P", damm.Transposition, 8},
+		{25, "7K3Q-Z0MM9-TP4P", damm.Insertion, 6},
+	} {
+		i := slices.IndexFunc(near, func(m damm.Match) bool { return m.Offset == tt.offset })
+		if i < 0 || near[i].Text != tt.text || near[i].Err == nil || len(near[i].Suggestions) == 0 {
+			t.Errorf("near misses = %+v; want %q at %d", near, tt.text, tt.offset)
+			continue
+		}
+		want := damm.Suggestion{Code: "7K3QZ0M9TP4P", Edit: tt.edit, Offset: tt.at, Score: near[i].Suggestions[0].Score}
+		if got := near[i].Suggestions[0]; got != want {
+			t.Errorf("suggestion for %q = %+v; want %+v", tt.text, got, want)
+		}
+	}
+	for _, m := range near {
+		for _, sg := range m.Suggestions {
+			if sg.Edit != damm.Transposition && sg.Edit != damm.Insertion || c.Verify(sg.Code) != nil {
+				t.Errorf("suggestion for %q = %+v; want a valid code by transposition or insertion", m.Text, sg)
+			}
+		}
+	}
+
+	// About a third of words of prose are a transposition or insertion
+	// away from a valid code, but few are written in groups.
+	prose := `It was a bright cold day in April, and the clocks were striking
+thirteen. Winston Smith, his chin nuzzled into his breast in an effort to
+escape the vile wind, slipped quickly through the glass doors of Victory
+Mansions, though not quickly enough to prevent a swirl of gritty dust from
+entering along with him. The hallway smelt of boiled cabbage and old rag
+mats. At one end of it a coloured poster, too large for indoor display,
+had been tacked to the wall. It depicted simply an enormous face, more than
+a metre wide: the face of a man of about forty-five, with a heavy black
+moustache and ruggedly handsome features. Notices in the well-lit lobby
+asked for a self-addressed reply by the twenty-third.`
+	near = nil
+	s.MinLen, s.MaxLen = 4, 12
+	if err := s.Scan(strings.NewReader(prose), func(damm.Match) error { return nil }); err != nil {
+		t.Fatal(err)
+	}
+	if words := len(strings.Fields(prose)); len(near) > 2 {
+		t.Errorf("%d near misses in %d words of prose: %+v", len(near), words, near)
+	}
+
+	s.Codec = mustCodec(t, foreignDamm{damm.New32()}, damm.Crockford)
+	if err := s.Scan(strings.NewReader(text), func(damm.Match) error { return nil }); !errors.Is(err, damm.ErrDigest) {
+		t.Errorf("Scan with a foreign Damm error = %v; want %v", err, damm.ErrDigest)
+	}
+}
+
+func TestTextScanner_long(t *testing.T) {
+	t.Parallel()
+	c := mustCodec(t, damm.New32(), damm.Crockford)
+	s := &damm.TextScanner{Codec: c, NearMisses: func(damm.Match) error { return nil }}
+	if got := scanAll(t, s, strings.Repeat("A", 5000)+" WORLD3"); len(got) != 1 || got[0].Code != "W0R1D3" {
+		t.Errorf("Scan = %+v; want WORLD3 only", got)
+	}
+}
+
+func TestTextScanner_stop(t *testing.T) {
+	t.Parallel()
+	c := mustCodec(t, damm.New32(), damm.Crockford)
+	s := &damm.TextScanner{Codec: c}
+	stop := errors.New("stop")
+	n := 0
+	err := s.Scan(strings.NewReader("WORLD3 HELLO8 C0FFEEA"), func(damm.Match) error {
+		n++
+		return stop
+	})
+	if err != stop || n != 1 {
+		t.Errorf("Scan = %v after %d matches; want %v after 1", err, n, stop)
+	}
+}
+
+func TestTextScanner_groups(t *testing.T) {
+	t.Parallel()
+	c := mustCodec(t, damm.New32(), damm.Crockford)
+	for _, groups := range [][]int{{0}, {4, -1}} {
+		s := &damm.TextScanner{Codec: c, Separators: "-", Groups: groups}
+		if err := s.Scan(strings.NewReader("WORLD3"), func(damm.Match) error { return nil }); err == nil {
+			t.Errorf("Scan with Groups %v error = nil", groups)
+		}
+		r := damm.NewRedactor(io.Discard, s, 0)
+		if _, err := r.Write([]byte("WORLD3")); err == nil {
+			t.Errorf("Redactor.Write with Groups %v error = nil", groups)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package damm
+
+// EncodeUint64 writes n as width base-Modulus() symbols of a, most
+// significant first and zero padded, followed by the check symbol.
+func EncodeUint64(d Damm, n uint64, width int, a *Alphabet) (string, error) {
+	c, err := NewCodec(d, a)
+	if err != nil {
+		return "", err
+	}
+	if width < 1 {
+		return "", &WidthError{Width: width, Len: -1}
+	}
+	base := uint64(d.Modulus())
+	digits := make([]int, width+1)
+	for i := width - 1; i >= 0; i-- {
+		digits[i] = int(n % base)
+		n /= base
+	}
+	if n != 0 {
+		return "", &OverflowError{Width: width}
+	}
+	digits[width] = d.Generate(digits[:width])
+	return c.a.Encode(digits)
+}
+
+// DecodeUint64 verifies a code produced by EncodeUint64 and returns its value.
+func DecodeUint64(d Damm, code string, width int, a *Alphabet) (uint64, error) {
+	c, err := NewCodec(d, a)
+	if err != nil {
+		return 0, err
+	}
+	if width < 1 {
+		return 0, &WidthError{Width: width, Len: -1}
+	}
+	if len(code) != width+1 {
+		return 0, &WidthError{Width: width, Len: max(len(code)-1, 0)}
+	}
+	digits, err := c.Decode(code)
+	if err != nil {
+		return 0, err
+	}
+	base := uint64(d.Modulus())
+	var n uint64
+	for _, digit := range digits {
+		if n > (^uint64(0)-uint64(digit))/base {
+			return 0, &OverflowError{Width: width}
+		}
+		n = n*base + uint64(digit)
+	}
+	return n, nil
+}
@@ -0,0 +1,107 @@
+package damm_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/go-oss/damm"
+)
+
+func TestEncodeUint64(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		d     damm.Damm
+		a     *damm.Alphabet
+		n     uint64
+		width int
+	}{
+		{damm.New32(), damm.Crockford, 0, 1},
+		{damm.New32(), damm.Crockford, 31, 1},
+		{damm.New32(), damm.Crockford, 1234567, 8},
+		{damm.New32(), damm.Crockford, math.MaxUint64, 13},
+		{damm.New64(), damm.Base64URL, 0, 4},
+		{damm.New64(), damm.Base64URL, math.MaxUint64, 11},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d/%d", tt.n, tt.width), func(t *testing.T) {
+			code, err := damm.EncodeUint64(tt.d, tt.n, tt.width, tt.a)
+			if err != nil {
+				t.Fatalf("EncodeUint64(%d, %d) error: %v", tt.n, tt.width, err)
+			}
+			if len(code) != tt.width+1 {
+				t.Errorf("len(%q) = %d; want %d", code, len(code), tt.width+1)
+			}
+			got, err := damm.DecodeUint64(tt.d, code, tt.width, tt.a)
+			if err != nil {
+				t.Fatalf("DecodeUint64(%q) error: %v", code, err)
+			}
+			if got != tt.n {
+				t.Errorf("DecodeUint64(%q) = %d; want %d", code, got, tt.n)
+			}
+		})
+	}
+}
+
+func TestEncodeUint64_sequential(t *testing.T) {
+	t.Parallel()
+	d32 := damm.New32()
+	prev := ""
+	for n := range uint64(2048) {
+		code, err := damm.EncodeUint64(d32, n, 4, damm.Crockford)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if code[:4] <= prev {
+			t.Fatalf("EncodeUint64(%d) = %q; not after %q", n, code, prev)
+		}
+		prev = code[:4]
+	}
+}
+
+func TestEncodeUint64_errors(t *testing.T) {
+	t.Parallel()
+	d32 := damm.New32()
+	var oe *damm.OverflowError
+	if _, err := damm.EncodeUint64(d32, 32, 1, damm.Crockford); !errors.As(err, &oe) {
+		t.Errorf("EncodeUint64(32, 1) error = %v; want *OverflowError", err)
+	}
+	var we *damm.WidthError
+	if _, err := damm.EncodeUint64(d32, 0, 0, damm.Crockford); !errors.As(err, &we) {
+		t.Errorf("EncodeUint64(0, 0) error = %v; want *WidthError", err)
+	}
+	if _, err := damm.EncodeUint64(d32, 0, 1, damm.Base64URL); !errors.Is(err, damm.ErrAlphabetSize) {
+		t.Errorf("EncodeUint64 error = %v; want ErrAlphabetSize", err)
+	}
+}
+
+func TestDecodeUint64_errors(t *testing.T) {
+	t.Parallel()
+	d32 := damm.New32()
+	code, _ := damm.EncodeUint64(d32, 42, 4, damm.Crockford)
+	var we *damm.WidthError
+	if _, err := damm.DecodeUint64(d32, code, 5, damm.Crockford); !errors.As(err, &we) {
+		t.Errorf("DecodeUint64(%q, 5) error = %v; want *WidthError", code, err)
+	}
+	var ce *damm.CheckError
+	bad := "1" + code[1:]
+	if _, err := damm.DecodeUint64(d32, bad, 4, damm.Crockford); !errors.As(err, &ce) {
+		t.Errorf("DecodeUint64(%q) error = %v; want *CheckError", bad, err)
+	}
+	var oe *damm.OverflowError
+	big, _ := damm.Crockford.Encode([]int{31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31})
+	big, _ = mustCodec(t, d32, damm.Crockford).Append(big)
+	if _, err := damm.DecodeUint64(d32, big, 13, damm.Crockford); !errors.As(err, &oe) {
+		t.Errorf("DecodeUint64(%q) error = %v; want *OverflowError", big, err)
+	}
+}
+
+func mustCodec(t testing.TB, d damm.Damm, a *damm.Alphabet) *damm.Codec {
+	t.Helper()
+	c, err := damm.NewCodec(d, a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
@@ -0,0 +1,88 @@
+package damm
+
+import "fmt"
+
+// UpdateCheck returns the check digit of a payload of length digits after
+// the digit at position, counting from 0, changes from oldSymbol to
+// newSymbol, given the check digit before the change. It takes O(log
+// length) field operations and returns ErrNotLinear if d is not a field
+// construction; UpdateCheckSuffix covers the other Damms. It returns an error
+// if position is not in [0, length) or a digit is not in [0, d.Modulus()).
+//
+// In a field construction a change of δ = a·(oldSymbol-newSymbol) in the
+// interim digit after position is multiplied by a at every later digit,
+// whatever the digits, so the check digit changes by a^(length-position-1)·δ.
+func UpdateCheck(d Damm, oldCheck, length, position, oldSymbol, newSymbol int) (int, error) {
+	lin, err := linearOf(d)
+	if err != nil {
+		return 0, err
+	}
+	if position < 0 || position >= length {
+		return 0, fmt.Errorf("damm: position %d out of range for length %d", position, length)
+	}
+	if err := checkDigits(d, oldCheck, oldSymbol, newSymbol); err != nil {
+		return 0, err
+	}
+	delta := lin.fieldMul(power(lin, lin.multiplier(), length-position-1), lin.step(oldSymbol, newSymbol))
+	return lin.fieldAdd(oldCheck, delta), nil
+}
+
+// UpdateCheckSuffix is UpdateCheck for any Damm made by this package, given
+// the digits that follow the changed one instead of the length. It walks
+// back from oldCheck over suffix and oldSymbol, which is possible because
+// x ↦ x∘y is a permutation for every y, and forward again over newSymbol
+// and suffix, so it takes time proportional to len(suffix) instead of the
+// length of the payload. It returns ErrDigest for other Damms, as
+// NewDigest does, and an error if a digit is not in [0, d.Modulus()).
+func UpdateCheckSuffix(d Damm, oldCheck int, suffix []int, oldSymbol, newSymbol int) (int, error) {
+	s, ok := d.(stepper)
+	if !ok {
+		return 0, ErrDigest
+	}
+	if err := checkDigits(d, oldCheck, oldSymbol, newSymbol); err != nil {
+		return 0, err
+	}
+	if err := checkDigits(d, suffix...); err != nil {
+		return 0, err
+	}
+	unstep := unstepper(s)
+	interim := oldCheck
+	for i := len(suffix) - 1; i >= 0; i-- {
+		interim = unstep(interim, suffix[i])
+	}
+	interim = s.step(unstep(interim, oldSymbol), newSymbol)
+	for _, digit := range suffix {
+		interim = s.step(interim, digit)
+	}
+	return interim, nil
+}
+
+// checkDigits returns an error if a digit is not in [0, d.Modulus()).
+func checkDigits(d Damm, digits ...int) error {
+	for _, v := range digits {
+		if v < 0 || v >= d.Modulus() {
+			return fmt.Errorf("damm: digit %d out of range for modulus %d", v, d.Modulus())
+		}
+	}
+	return nil
+}
+
+// unstepper returns the inverse of s.step for a given digit: unstep(z, y)
+// is the x with s.step(x, y) = z.
+func unstepper(s stepper) func(interim, digit int) int {
+	switch d := s.(type) {
+	case *seeded:
+		return unstepper(d.stepper)
+	case *tableDamm:
+		return func(interim, digit int) int {
+			return d.inv[digit][interim]
+		}
+	case linear:
+		// x = a⁻¹·z + y, where a⁻¹ = a^(q-2).
+		inv := power(d, d.multiplier(), d.Modulus()-2)
+		return func(interim, digit int) int {
+			return d.fieldAdd(d.fieldMul(inv, interim), digit)
+		}
+	}
+	panic("damm: unknown stepper")
+}
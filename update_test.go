@@ -0,0 +1,126 @@
+package damm_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/go-oss/damm"
+)
+
+var updateDamms = []struct {
+	name string
+	d    damm.Damm
+}{
+	{"New32", damm.New32()},
+	{"New64", damm.New64()},
+	{"New32 a=5", damm.New32(damm.WithMultiplier(5))},
+	{"New32 constant time", damm.New32(damm.WithConstantTime())},
+	{"New64 domain", damm.New64(damm.WithDomain("coupon"))},
+	{"GF(7)", must(damm.NewField(7, 1))},
+	{"GF(3^4)", must(damm.NewField(3, 4, damm.WithLengthBinding()))},
+	{"New36", damm.New36()},
+	{"New62 length binding", damm.New62(damm.WithLengthBinding())},
+}
+
+// editPayload returns a random payload, a position in it and a new symbol
+// for that position.
+func editPayload(r *rand.Rand, q int) (payload []int, position, symbol int) {
+	payload = make([]int, 1+r.IntN(100))
+	for i := range payload {
+		payload[i] = r.IntN(q)
+	}
+	return payload, r.IntN(len(payload)), r.IntN(q)
+}
+
+func TestUpdateCheck(t *testing.T) {
+	t.Parallel()
+	for _, tt := range updateDamms {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := rand.New(rand.NewPCG(1, 2))
+			for range 500 {
+				payload, i, symbol := editPayload(r, tt.d.Modulus())
+				edited := slices.Clone(payload)
+				edited[i] = symbol
+				got, err := damm.UpdateCheck(tt.d, tt.d.Generate(payload), len(payload), i, payload[i], symbol)
+				if errors.Is(err, damm.ErrNotLinear) {
+					t.Skip("not a field construction")
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				if want := tt.d.Generate(edited); got != want {
+					t.Fatalf("UpdateCheck(%v, %d: %d → %d) = %d; want %d", payload, i, payload[i], symbol, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateCheckSuffix(t *testing.T) {
+	t.Parallel()
+	for _, tt := range updateDamms {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := rand.New(rand.NewPCG(1, 2))
+			for range 500 {
+				payload, i, symbol := editPayload(r, tt.d.Modulus())
+				edited := slices.Clone(payload)
+				edited[i] = symbol
+				got, err := damm.UpdateCheckSuffix(tt.d, tt.d.Generate(payload), payload[i+1:], payload[i], symbol)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if want := tt.d.Generate(edited); got != want {
+					t.Fatalf("UpdateCheckSuffix(%v, %d: %d → %d) = %d; want %d", payload, i, payload[i], symbol, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateCheck_errors(t *testing.T) {
+	t.Parallel()
+	if _, err := damm.UpdateCheck(damm.New36(), 0, 3, 1, 2, 3); !errors.Is(err, damm.ErrNotLinear) {
+		t.Errorf("UpdateCheck(New36) error = %v; want %v", err, damm.ErrNotLinear)
+	}
+	if _, err := damm.UpdateCheckSuffix(foreignDamm{damm.New32()}, 0, nil, 2, 3); !errors.Is(err, damm.ErrDigest) {
+		t.Errorf("UpdateCheckSuffix(foreign Damm) error = %v; want %v", err, damm.ErrDigest)
+	}
+	d := damm.New32()
+	for _, tt := range []struct {
+		name                                             string
+		oldCheck, length, position, oldSymbol, newSymbol int
+	}{
+		{"position past the end", 5, 3, 7, 2, 3},
+		{"position at the end", 5, 3, 3, 2, 3},
+		{"negative position", 5, 3, -1, 2, 3},
+		{"zero length", 5, 0, 0, 2, 3},
+		{"negative length", 5, -2, 0, 2, 3},
+		{"old symbol", 5, 3, 1, 32, 3},
+		{"new symbol", 5, 3, 1, 2, 99},
+		{"negative symbol", 5, 3, 1, -1, 3},
+		{"old check", 32, 3, 1, 2, 3},
+	} {
+		if got, err := damm.UpdateCheck(d, tt.oldCheck, tt.length, tt.position, tt.oldSymbol, tt.newSymbol); err == nil {
+			t.Errorf("UpdateCheck(%s) = %d, nil; want error", tt.name, got)
+		}
+	}
+	for _, tt := range []struct {
+		name                 string
+		oldCheck             int
+		suffix               []int
+		oldSymbol, newSymbol int
+	}{
+		{"old symbol", 5, []int{1}, 32, 3},
+		{"new symbol", 5, []int{1}, 2, 99},
+		{"suffix", 5, []int{1, -4}, 2, 3},
+		{"old check", -1, []int{1}, 2, 3},
+	} {
+		if got, err := damm.UpdateCheckSuffix(d, tt.oldCheck, tt.suffix, tt.oldSymbol, tt.newSymbol); err == nil {
+			t.Errorf("UpdateCheckSuffix(%s) = %d, nil; want error", tt.name, got)
+		}
+	}
+}
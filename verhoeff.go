@@ -0,0 +1,62 @@
+package damm
+
+// Multiplication table of the dihedral group D5.
+var verhoeffD = [10][10]int{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+	{1, 2, 3, 4, 0, 6, 7, 8, 9, 5},
+	{2, 3, 4, 0, 1, 7, 8, 9, 5, 6},
+	{3, 4, 0, 1, 2, 8, 9, 5, 6, 7},
+	{4, 0, 1, 2, 3, 9, 5, 6, 7, 8},
+	{5, 9, 8, 7, 6, 0, 4, 3, 2, 1},
+	{6, 5, 9, 8, 7, 1, 0, 4, 3, 2},
+	{7, 6, 5, 9, 8, 2, 1, 0, 4, 3},
+	{8, 7, 6, 5, 9, 3, 2, 1, 0, 4},
+	{9, 8, 7, 6, 5, 4, 3, 2, 1, 0},
+}
+
+// Powers of the permutation (1 5 8 9 4 2 7 0)(3 6) applied by position.
+var verhoeffP = [8][10]int{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+	{1, 5, 7, 6, 2, 8, 3, 0, 9, 4},
+	{5, 8, 0, 3, 7, 9, 6, 1, 4, 2},
+	{8, 9, 1, 6, 0, 4, 3, 5, 2, 7},
+	{9, 4, 5, 3, 1, 2, 6, 8, 7, 0},
+	{4, 2, 8, 6, 5, 7, 3, 9, 0, 1},
+	{2, 7, 9, 3, 8, 0, 6, 4, 1, 5},
+	{7, 0, 4, 6, 9, 1, 3, 2, 5, 8},
+}
+
+var verhoeffInv = [10]int{0, 4, 3, 2, 1, 5, 6, 7, 8, 9}
+
+type verhoeff struct{}
+
+// NewVerhoeff returns the Verhoeff algorithm over decimal digits. For a
+// digit outside 0..9, Generate returns -1 and Verify returns false.
+func NewVerhoeff() Checker {
+	return verhoeff{}
+}
+
+// verhoeffSum returns the Verhoeff checksum of digits, or -1 for a digit
+// outside 0..9.
+func verhoeffSum(digits []int, offset int) (c int) {
+	for i := range digits {
+		digit := digits[len(digits)-1-i]
+		if uint(digit) > 9 {
+			return -1
+		}
+		c = verhoeffD[c][verhoeffP[(i+offset)%8][digit]]
+	}
+	return c
+}
+
+func (verhoeff) Generate(digits []int) int {
+	c := verhoeffSum(digits, 1)
+	if c < 0 {
+		return -1
+	}
+	return verhoeffInv[c]
+}
+
+func (verhoeff) Verify(digits []int) bool {
+	return verhoeffSum(digits, 0) == 0
+}
@@ -0,0 +1,65 @@
+package damm_test
+
+import (
+	"testing"
+
+	"github.com/go-oss/damm"
+)
+
+func TestVerhoeff(t *testing.T) {
+	t.Parallel()
+	verhoeff := damm.NewVerhoeff()
+	tests := []struct {
+		payload string
+		want    int
+	}{
+		{"236", 3},
+		{"12345", 1},
+		{"142857", 0},
+		{"123456789012", 0},
+		{"8473643095483728456789", 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.payload, func(t *testing.T) {
+			digits := decimal(tt.payload)
+			if got := verhoeff.Generate(digits); got != tt.want {
+				t.Errorf("Generate(%v) = %d; want %d", digits, got, tt.want)
+			}
+			if !verhoeff.Verify(append(digits, tt.want)) {
+				t.Errorf("Verify(%v) = false; want true", append(digits, tt.want))
+			}
+		})
+	}
+	assertSingleErrors(t, verhoeff, decimal("8473643095483728456789"), 10)
+	assertTranspositions(t, verhoeff, decimal("8473643095483728456789"))
+}
+
+// assertTranspositions checks that every adjacent transposition of distinct
+// symbols in the checked code of payload is detected.
+func assertTranspositions(t *testing.T, c damm.Checker, payload []int) {
+	t.Helper()
+	code := append(payload[:len(payload):len(payload)], c.Generate(payload))
+	for i := range len(code) - 1 {
+		if code[i] == code[i+1] {
+			continue
+		}
+		bad := append([]int(nil), code...)
+		bad[i], bad[i+1] = bad[i+1], bad[i]
+		if c.Verify(bad) {
+			t.Fatalf("Verify(%v) = true; want false", bad)
+		}
+	}
+}
+
+func TestVerhoeff_outOfRange(t *testing.T) {
+	t.Parallel()
+	verhoeff := damm.NewVerhoeff()
+	for _, digits := range [][]int{{12}, {-1}, {2, 3, 10}, {1 << 40, 0}} {
+		if got := verhoeff.Generate(digits); got != -1 {
+			t.Errorf("Generate(%v) = %d; want -1", digits, got)
+		}
+		if verhoeff.Verify(digits) {
+			t.Errorf("Verify(%v) = true; want false", digits)
+		}
+	}
+}
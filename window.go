@@ -0,0 +1,88 @@
+package damm
+
+import "errors"
+
+// Window verifies the last Len digits of a stream as a code, in constant
+// time per digit, for finding codes of a fixed length in a longer stream.
+//
+// For a field construction, the interim digit after the window y_0 … y_{L-1}
+// is a^L·s - T with T = Σ a^(L-k)·y_k, where s is the seed of the Damm, so
+// the window is a valid code if T = a^L·s. Moving the window by one digit
+// takes T to a·T + a·y_L - a^(L+1)·y_0, which needs no other digit.
+type Window struct {
+	lin    linear
+	length int
+	target int   // a^L·s
+	mulA   []int // mulA[y] = a·y
+	mulOut []int // mulOut[y] = -a^(L+1)·y
+	ring   []int // the last length digits, oldest at next
+	next   int
+	pushed int
+	t      int
+}
+
+// NewWindow returns a window of length digits over d, which must be a field
+// construction, as for Summarize.
+func NewWindow(d Damm, length int) (*Window, error) {
+	lin, err := linearOf(d)
+	if err != nil {
+		return nil, err
+	}
+	if length < 1 {
+		return nil, errors.New("damm: window length must be positive")
+	}
+	q := d.Modulus()
+	aL := power(lin, lin.multiplier(), length)
+	w := &Window{
+		lin:    lin,
+		length: length,
+		target: lin.fieldMul(aL, d.Generate(nil)),
+		mulA:   make([]int, q),
+		mulOut: make([]int, q),
+		ring:   make([]int, length),
+	}
+	for y := range q {
+		w.mulA[y] = lin.step(y, 0)
+		// step(0, y) = -a·y.
+		w.mulOut[y] = lin.fieldMul(aL, lin.step(0, y))
+	}
+	return w, nil
+}
+
+func (w *Window) Len() int {
+	return w.length
+}
+
+// Push adds digit to the stream and reports whether the last Len digits
+// form a valid code.
+func (w *Window) Push(digit int) bool {
+	out := w.ring[w.next]
+	w.ring[w.next] = digit
+	w.next = (w.next + 1) % w.length
+	w.t = w.lin.fieldAdd(w.lin.fieldAdd(w.mulA[w.t], w.mulA[digit]), w.mulOut[out])
+	w.pushed++
+	return w.pushed >= w.length && w.t == w.target
+}
+
+// Reset empties the window, as at the start of a new stream.
+func (w *Window) Reset() {
+	clear(w.ring)
+	w.next, w.pushed, w.t = 0, 0, 0
+}
+
+// FindCodes returns the offsets in digits of all runs of length digits that
+// are valid codes under d, which must be a field construction. Runs may
+// overlap.
+func FindCodes(d Damm, digits []int, length int) ([]int, error) {
+	w, err := NewWindow(d, length)
+	if err != nil {
+		return nil, err
+	}
+	var offsets []int
+	for i, digit := range digits {
+		if w.Push(digit) {
+			offsets = append(offsets, i+1-length)
+		}
+	}
+	return offsets, nil
+}
@@ -0,0 +1,97 @@
+package damm_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/go-oss/damm"
+)
+
+func TestFindCodes(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		name string
+		d    damm.Damm
+	}{
+		{"New32", damm.New32()},
+		{"New64", damm.New64()},
+		{"New32 a=9", damm.New32(damm.WithMultiplier(9))},
+		{"New64 domain", damm.New64(damm.WithDomain("user"), damm.WithLengthBinding())},
+		{"GF(5^2)", must(damm.NewField(5, 2))},
+		{"GF(3)", must(damm.NewField(3, 1))},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := rand.New(rand.NewPCG(1, 2))
+			q := tt.d.Modulus()
+			for _, length := range []int{1, 2, 5, 12} {
+				stream := make([]int, 2000)
+				for i := range stream {
+					stream[i] = r.IntN(q)
+				}
+				// Plant some codes so that not every match is by chance.
+				for range 20 {
+					i := r.IntN(len(stream) - length + 1)
+					stream[i+length-1] = tt.d.Generate(stream[i : i+length-1])
+				}
+				var want []int
+				for i := 0; i+length <= len(stream); i++ {
+					if tt.d.Verify(stream[i : i+length]) {
+						want = append(want, i)
+					}
+				}
+				got, err := damm.FindCodes(tt.d, stream, length)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !slices.Equal(got, want) {
+					t.Fatalf("FindCodes(length %d) = %v; want %v", length, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestWindow_Reset(t *testing.T) {
+	t.Parallel()
+	d := damm.New32()
+	w, err := damm.NewWindow(d, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := []int{9, 8, 7, d.Generate([]int{9, 8, 7})}
+	for _, digit := range []int{1, 2, 3} {
+		w.Push(digit)
+	}
+	w.Reset()
+	for i, digit := range code {
+		if got, want := w.Push(digit), i == len(code)-1; got != want {
+			t.Errorf("Push(%d) after Reset = %v; want %v", digit, got, want)
+		}
+	}
+}
+
+func TestNewWindow_errors(t *testing.T) {
+	t.Parallel()
+	if _, err := damm.NewWindow(damm.New36(), 4); !errors.Is(err, damm.ErrNotLinear) {
+		t.Errorf("NewWindow(New36) error = %v; want %v", err, damm.ErrNotLinear)
+	}
+	if _, err := damm.NewWindow(damm.New32(), 0); err == nil {
+		t.Error("NewWindow(New32, 0) error = nil")
+	}
+}
+
+func BenchmarkFindCodes(b *testing.B) {
+	d := damm.New32()
+	stream := make([]int, 1<<16)
+	r := rand.New(rand.NewPCG(1, 2))
+	for i := range stream {
+		stream[i] = r.IntN(32)
+	}
+	b.ResetTimer()
+	for range b.N {
+		damm.FindCodes(d, stream, 16)
+	}
+}